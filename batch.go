@@ -0,0 +1,85 @@
+package chronos
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// batchChunk 并发解析时每次领取的输入个数
+const batchChunk = 256
+
+// ParseSlice 批量解析同一类型的输入，选项只整理一次
+// 返回的结果与错误均与输入按位置一一对应，解析失败的位置结果为零值、错误不为 nil
+func ParseSlice[T TimeValue](values []T, opts ...func(*ParseOption)) ([]time.Time, []error) {
+	p := parserOf(opts)
+	return p.parseBatch(len(values), func(i int) any { return values[i] })
+}
+
+// ParseAll 批量解析混合类型的输入，如读取自 JSON 或 CSV 的单元格，选项只整理一次
+// 返回的结果与错误均与输入按位置一一对应，解析失败的位置结果为零值、错误不为 nil
+func ParseAll(values []any, opts ...func(*ParseOption)) ([]time.Time, []error) {
+	return parserOf(opts).ParseAll(values)
+}
+
+// ParseAll 批量解析，返回的结果与错误均与输入按位置一一对应
+// 通过 ParseWithWorkers 指定并发数，结果与逐个解析一致
+func (p *Parser) ParseAll(values []any) ([]time.Time, []error) {
+	return p.parseBatch(len(values), func(i int) any { return values[i] })
+}
+
+func (p *Parser) parseBatch(n int, value func(i int) any) ([]time.Time, []error) {
+	results := make([]time.Time, n)
+	errs := make([]error, n)
+	parse := func(i int) {
+		if at, err := p.Parse(value(i)); err != nil {
+			errs[i] = err
+		} else {
+			results[i] = *at
+		}
+	}
+
+	workers := p.cnf.workers
+	if workers > (n+batchChunk-1)/batchChunk {
+		workers = (n + batchChunk - 1) / batchChunk
+	}
+	if workers <= 1 {
+		for i := 0; i < n; i++ {
+			parse(i)
+		}
+		return results, errs
+	}
+
+	// 各 goroutine 按块领取输入，写入各自位置，互不冲突
+	var next atomic.Int64
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for {
+				start := int(next.Add(batchChunk)) - batchChunk
+				if start >= n {
+					return
+				}
+				for i := start; i < min(start+batchChunk, n); i++ {
+					parse(i)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	return results, errs
+}
+
+// ParseWithWorkers 指定批量解析的并发数，n 小于 1 时使用 runtime.GOMAXPROCS(0)，默认逐个解析
+func ParseWithWorkers(n int) func(*ParseOption) {
+	return func(p *ParseOption) {
+		workers := n
+		if workers < 1 {
+			workers = runtime.GOMAXPROCS(0)
+		}
+		p.workers = workers
+	}
+}
@@ -0,0 +1,95 @@
+package chronos_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gomooth/chronos"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSlice(t *testing.T) {
+	inputs := []string{"2023-04-22T18:22:15Z", "invalid", "2023-04-22", "1672643045", ""}
+
+	results, errs := chronos.ParseSlice(inputs, chronos.ParseWithLocation(time.UTC))
+	assert.Len(t, results, len(inputs))
+	assert.Len(t, errs, len(inputs))
+
+	assert.NoError(t, errs[0])
+	assert.Equal(t, time.Date(2023, 4, 22, 18, 22, 15, 0, time.UTC), results[0])
+	assert.Error(t, errs[1])
+	assert.True(t, results[1].IsZero())
+	assert.NoError(t, errs[2])
+	assert.Equal(t, time.Date(2023, 4, 22, 0, 0, 0, 0, time.UTC), results[2])
+	assert.NoError(t, errs[3])
+	assert.Equal(t, time.Unix(1672643045, 0).Unix(), results[3].Unix())
+	assert.Error(t, errs[4])
+
+	t.Run("empty", func(t *testing.T) {
+		results, errs := chronos.ParseSlice([]int64{})
+		assert.Empty(t, results)
+		assert.Empty(t, errs)
+	})
+}
+
+func TestParseAll(t *testing.T) {
+	var nilTime *time.Time
+	at := time.Date(2023, 4, 22, 18, 22, 15, 0, time.UTC)
+
+	// 构造足够多的混合输入，覆盖多个并发块
+	inputs := make([]any, 0, 5000)
+	for i := 0; i < 1000; i++ {
+		inputs = append(inputs,
+			fmt.Sprintf("2023-04-%02dT18:22:15Z", i%28+1),
+			int64(1672643045123+i),
+			"22/09/2023",
+			at,
+			fmt.Sprintf("bad-%d", i),
+		)
+	}
+	inputs = append(inputs, nilTime, &at)
+
+	opts := []func(*chronos.ParseOption){chronos.ParseWithLocation(time.UTC), chronos.ParseWithLayout("02/01/2006")}
+	serial, serialErrs := chronos.ParseAll(inputs, opts...)
+
+	for _, workers := range []int{0, 1, 2, 8} {
+		t.Run(fmt.Sprintf("workers %d", workers), func(t *testing.T) {
+			results, errs := chronos.NewParser(append(opts, chronos.ParseWithWorkers(workers))...).ParseAll(inputs)
+			assert.Equal(t, serial, results)
+			for i := range inputs {
+				assert.Equal(t, serialErrs[i] == nil, errs[i] == nil, "index %d", i)
+			}
+		})
+	}
+
+	for i, v := range inputs {
+		at, err := chronos.NewParser(opts...).Parse(v)
+		if err != nil {
+			assert.Error(t, serialErrs[i], "index %d", i)
+			continue
+		}
+		assert.NoError(t, serialErrs[i], "index %d", i)
+		assert.Equal(t, *at, serial[i], "index %d", i)
+	}
+	assert.Error(t, serialErrs[len(inputs)-2], "nil *time.Time")
+}
+
+func BenchmarkParseSlice(b *testing.B) {
+	inputs := make([]string, 10000)
+	for i := range inputs {
+		inputs[i] = fmt.Sprintf("2023-04-%02d 18:22:15", i%28+1)
+	}
+
+	b.Run("serial", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			chronos.ParseSlice(inputs, chronos.ParseWithLocation(time.UTC))
+		}
+	})
+	b.Run("parallel", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			chronos.ParseSlice(inputs, chronos.ParseWithLocation(time.UTC), chronos.ParseWithWorkers(0))
+		}
+	})
+}
@@ -108,6 +108,18 @@ func TestDiff(t *testing.T) {
 		assert.Equal(t, "-1h30m", d.String())
 	})
 
+	// ISO 8601 格式测试
+	t.Run("ISO", func(t *testing.T) {
+		assert.Equal(t, "PT1H30M", chronos.Diff(t2, t1).ISO())
+		assert.Equal(t, "-PT1H30M", chronos.Diff(t1, t2).ISO())
+		assert.Equal(t, "PT0S", chronos.Diff(t1, t1).ISO())
+		assert.Equal(t, "-PT0.5S", chronos.DiffValue(-500*time.Millisecond).ISO())
+
+		d, err := chronos.ParseISODuration(chronos.DiffValue(90061500 * time.Millisecond).ISO())
+		assert.NoError(t, err)
+		assert.Equal(t, 90061500*time.Millisecond, d.Time)
+	})
+
 	// 零值时间测试
 	t.Run("Zero time", func(t *testing.T) {
 		d := chronos.Diff(time.Time{}, t1)
@@ -3,6 +3,8 @@ package chronos
 import (
 	"strconv"
 	"time"
+
+	"github.com/gomooth/chronos/internal/parse"
 )
 
 // DiffValue 差值，单位：纳秒
@@ -118,3 +120,8 @@ func (d DiffValue) String() string {
 		return sign + formatTime(hours, "h") + formatTime(val, "m") + formatTime(sec, "s")
 	}
 }
+
+// ISO 返回 ISO 8601 时长格式，只使用时、分、秒，如 "PT1H2M3S"、"-PT0.5S"，无时间差则返回 "PT0S"
+func (d DiffValue) ISO() string {
+	return parse.FormatISODuration(time.Duration(d))
+}
@@ -0,0 +1,26 @@
+package chronos
+
+import (
+	"time"
+
+	"github.com/gomooth/chronos/internal/parse"
+)
+
+// ISODuration ISO 8601 时长，年、月、周、日为日历部分，时、分、秒为精确时长，符号由 Negative 表示
+// String 格式化为 ISO 8601 时长，AddTo、SubtractFrom 按日历加减
+type ISODuration = parse.ISODuration
+
+// ParseISODuration 解析 ISO 8601 时长，如 "P1Y2M3DT4H5M6.5S"、"PT15M"、"P2W"、"-P1D"
+// 日历部分与精确时长分开保存，不互相换算，格式化后与输入等价
+func ParseISODuration(s string) (ISODuration, error) {
+	d, err := parse.ParseISODuration(s)
+	if err != nil {
+		return ISODuration{}, newParseError(s, InputISO8601, err)
+	}
+	return d, nil
+}
+
+// FormatISODuration 将精确时长格式化为 ISO 8601 时长，如 "PT1H2M3S"、"-PT0.5S"，不换算为天
+func FormatISODuration(d time.Duration) string {
+	return parse.FormatISODuration(d)
+}
@@ -0,0 +1,34 @@
+package chronos_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gomooth/chronos"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseISODuration(t *testing.T) {
+	d, err := chronos.ParseISODuration("P1Y2M3DT4H5M6.5S")
+	assert.NoError(t, err)
+	assert.Equal(t, chronos.ISODuration{Years: 1, Months: 2, Days: 3, Time: 4*time.Hour + 5*time.Minute + 6500*time.Millisecond}, d)
+	assert.Equal(t, "P1Y2M3DT4H5M6.5S", d.String())
+
+	// 日历部分按日历计算
+	at := time.Date(2023, 1, 31, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2024, 4, 3, 4, 5, 6, 500000000, time.UTC), d.AddTo(at))
+
+	d, err = chronos.ParseISODuration("-PT0.25S")
+	assert.NoError(t, err)
+	assert.True(t, d.Negative)
+	assert.Equal(t, "-PT0.25S", d.String())
+	assert.Equal(t, "-PT0.25S", chronos.FormatISODuration(-250*time.Millisecond))
+
+	_, err = chronos.ParseISODuration("P1.5D")
+	var pe *chronos.ParseError
+	if assert.ErrorAs(t, err, &pe) {
+		assert.Equal(t, "P1.5D", pe.Input)
+		assert.Equal(t, "day", pe.Component)
+	}
+}
@@ -0,0 +1,40 @@
+package chronos
+
+import (
+	"errors"
+
+	"github.com/gomooth/chronos/internal/parse"
+)
+
+// ParseError 时间解析错误，包含原始输入、输入类型、尝试过的格式及出错的部分
+// 可通过 errors.As 获取，通过 errors.Is 判断是否为以下错误
+type ParseError = parse.ParseError
+
+var (
+	ErrUnsupportedExpression = parse.ErrUnsupportedExpression // 输入无法识别
+	ErrAmbiguous             = parse.ErrAmbiguous             // 输入存在多种有效解读，如严格模式下的 "04/05/2023"
+	ErrOutOfRange            = parse.ErrOutOfRange            // 输入可以识别，但某一部分超出有效范围，如 "2023-02-30"
+	ErrUnknownUnit           = parse.ErrUnknownUnit           // 未知的时间单位、时间戳单位或日期系统
+)
+
+// newParseError 为解析错误补充原始输入，非 ParseError 的错误包装为 ParseError
+func newParseError(input string, kind InputKind, err error) *ParseError {
+	var pe *ParseError
+	if !errors.As(err, &pe) {
+		return &ParseError{Input: input, Kind: kind, Err: err}
+	}
+	e := *pe
+	e.Input = input
+	if e.Kind == InputUnknown {
+		e.Kind = kind
+	}
+	return &e
+}
+
+// preferError 多种方式均解析失败时，优先保留更具体的错误（如超出范围），而不是无法识别
+func preferError(current, next error) error {
+	if errors.Is(current, ErrUnsupportedExpression) && !errors.Is(next, ErrUnsupportedExpression) {
+		return next
+	}
+	return current
+}
@@ -1,7 +1,10 @@
 package chronos
 
 import (
+	"time"
+
 	"github.com/gomooth/chronos/internal/helper"
+	"github.com/gomooth/chronos/internal/parse"
 )
 
 // IsLeap 判断是否为闰年
@@ -15,3 +18,34 @@ func DaysInMonth[T MixedTime](v T) int {
 	at := getTime(v)
 	return helper.DaysInMonth(at.Year(), at.Month())
 }
+
+// ToExcelSerial 将时间按其所在时区的墙上时间转换为电子表格序列号，如 2023-04-22 18:00:00 => 45038.75
+func ToExcelSerial[T MixedTime](v T, system ExcelSystem) (float64, error) {
+	return parse.ToExcelSerial(getTime(v), system)
+}
+
+// ToEpoch 将时间转换为纪元计数，不足一个单位的部分舍去，如 EpochFileTime 下 2023-04-22 18:22:15 UTC => 133266613350000000
+func ToEpoch[T MixedTime](v T, epoch Epoch) (int64, error) {
+	ticks, _, err := epoch.Ticks(getTime(v))
+	return ticks, err
+}
+
+// ToEpochFloat 将时间转换为带小数的纪元计数，适用于儒略日等以天为单位的纪元
+func ToEpochFloat[T MixedTime](v T, epoch Epoch) (float64, error) {
+	return epoch.Float(getTime(v))
+}
+
+// ParseGPSWeek 按 GPS 周数及周内秒解析，如 2258、584553 => 2023-04-22 18:22:15 UTC
+// week 为自 1980-01-06 起的完整周数，不按 1024 周翻转；seconds 须在 [0, 604800) 之间
+func ParseGPSWeek(week int, seconds float64) (*time.Time, error) {
+	res, err := parse.FromGPSWeek(week, seconds)
+	if err != nil {
+		return nil, err
+	}
+	return &res.Time, nil
+}
+
+// ToGPSWeek 将时间转换为 GPS 周数及周内秒
+func ToGPSWeek[T MixedTime](v T) (week int, seconds float64, err error) {
+	return parse.GPSWeek(getTime(v))
+}
@@ -1,6 +1,7 @@
 package chronos_test
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -136,3 +137,61 @@ func TestDaysInMonth(t *testing.T) {
 func ptr(t time.Time) *time.Time {
 	return &t
 }
+
+func TestToExcelSerial(t *testing.T) {
+	at := time.Date(2023, 4, 22, 18, 0, 0, 0, time.UTC)
+
+	got, err := chronos.ToExcelSerial(at, chronos.Excel1900)
+	if err != nil || got != 45038.75 {
+		t.Errorf("ToExcelSerial(%v, Excel1900) = %v, %v, want 45038.75", at, got, err)
+	}
+	got, err = chronos.ToExcelSerial(&at, chronos.Excel1904)
+	if err != nil || got != 43576.75 {
+		t.Errorf("ToExcelSerial(%v, Excel1904) = %v, %v, want 43576.75", at, got, err)
+	}
+
+	// 往返转换
+	parsed, err := chronos.Parse("45038.75", chronos.ParseWithExcelSerial(chronos.Excel1900), chronos.ParseWithLocation(time.UTC))
+	if err != nil || !parsed.Equal(at) {
+		t.Errorf("Parse(45038.75) = %v, %v, want %v", parsed, err, at)
+	}
+}
+
+func TestToEpoch(t *testing.T) {
+	at := time.Date(2023, 4, 22, 18, 22, 15, 0, time.UTC)
+
+	ticks, err := chronos.ToEpoch(at, chronos.EpochFileTime)
+	if err != nil || ticks != 133266613350000000 {
+		t.Errorf("ToEpoch(%v, EpochFileTime) = %d, %v, want 133266613350000000", at, ticks, err)
+	}
+	ticks, err = chronos.ToEpoch(&at, chronos.EpochGPS)
+	if err != nil || ticks != 1366222953 {
+		t.Errorf("ToEpoch(%v, EpochGPS) = %d, %v, want 1366222953", at, ticks, err)
+	}
+	mjd, err := chronos.ToEpochFloat(time.Date(2023, 4, 22, 18, 0, 0, 0, time.UTC), chronos.EpochModifiedJulianDay)
+	if err != nil || mjd != 60056.75 {
+		t.Errorf("ToEpochFloat(EpochModifiedJulianDay) = %v, %v, want 60056.75", mjd, err)
+	}
+	if _, err = chronos.ToEpoch(time.Date(2040, 1, 1, 0, 0, 0, 0, time.UTC), chronos.EpochNTP); !errors.Is(err, chronos.ErrOutOfRange) {
+		t.Errorf("ToEpoch(2040-01-01, EpochNTP) error = %v, want ErrOutOfRange", err)
+	}
+}
+
+func TestGPSWeek(t *testing.T) {
+	at := time.Date(2023, 4, 22, 18, 22, 15, 500000000, time.UTC)
+
+	week, seconds, err := chronos.ToGPSWeek(at)
+	if err != nil || week != 2258 || seconds != 584553.5 {
+		t.Errorf("ToGPSWeek(%v) = %d, %v, %v, want 2258, 584553.5", at, week, seconds, err)
+	}
+	parsed, err := chronos.ParseGPSWeek(week, seconds)
+	if err != nil || !parsed.Equal(at) {
+		t.Errorf("ParseGPSWeek(%d, %v) = %v, %v, want %v", week, seconds, parsed, err, at)
+	}
+	if _, err = chronos.ParseGPSWeek(2258, 604800); !errors.Is(err, chronos.ErrOutOfRange) {
+		t.Errorf("ParseGPSWeek(2258, 604800) error = %v, want ErrOutOfRange", err)
+	}
+	if _, _, err = chronos.ToGPSWeek(time.Date(1979, 1, 1, 0, 0, 0, 0, time.UTC)); !errors.Is(err, chronos.ErrOutOfRange) {
+		t.Errorf("ToGPSWeek(1979-01-01) error = %v, want ErrOutOfRange", err)
+	}
+}
@@ -0,0 +1,33 @@
+package parse
+
+import "time"
+
+// isTimeOnly 格式中只有时间，没有日期，如 time.Kitchen、time.TimeOnly
+// 两个日期的年、月、日、星期均不同，格式化结果一致说明格式中没有日期
+func isTimeOnly(layout string) bool {
+	ref := time.Date(2001, time.January, 1, 15, 4, 5, 0, time.UTC)
+	return ref.Format(layout) == ref.AddDate(6, 9, 16).Format(layout)
+}
+
+// anchorDate 将仅含时间的结果置于锚定日期，开启顺延时早于基准时间则顺延到下一天
+func (f *StringFormat) anchorDate(t time.Time) time.Time {
+	base := f.base().In(t.Location())
+	year, month, day := base.Date()
+	if f.anchor != nil && !f.anchor.IsZero() {
+		year, month, day = f.anchor.Date()
+	}
+
+	anchored := time.Date(year, month, day, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	if f.next && anchored.Before(base) {
+		anchored = time.Date(year, month, day+1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	}
+	return anchored
+}
+
+// base 基准时间，未指定时取当前时间
+func (f *StringFormat) base() time.Time {
+	if f.baseTime != nil {
+		return *f.baseTime
+	}
+	return time.Now()
+}
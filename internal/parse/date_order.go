@@ -0,0 +1,57 @@
+package parse
+
+// DateOrder 纯数字日期中年、月、日的顺序
+type DateOrder int
+
+const (
+	DateOrderNone DateOrder = iota
+	DateOrderDMY            // 日/月/年，如 22/04/2023
+	DateOrderMDY            // 月/日/年，如 04/22/2023
+	DateOrderYMD            // 年/月/日，如 2023/04/22
+)
+
+func (o DateOrder) String() string {
+	switch o {
+	case DateOrderDMY:
+		return "DMY"
+	case DateOrderMDY:
+		return "MDY"
+	case DateOrderYMD:
+		return "YMD"
+	default:
+		return "none"
+	}
+}
+
+var dateOrderLayouts = map[DateOrder][]string{
+	DateOrderDMY: numericDateLayouts("2", "1", "2006", "06"),
+	DateOrderMDY: numericDateLayouts("1", "2", "2006", "06"),
+	DateOrderYMD: numericDateLayouts("2006", "1", "2"),
+}
+
+// numericDateLayouts 按给定的字段顺序生成以 / . - 分隔的日期格式，以及带时间的格式
+func numericDateLayouts(first, second string, thirds ...string) []string {
+	layouts := make([]string, 0, len(thirds)*9)
+	for _, third := range thirds {
+		for _, sep := range []string{"/", ".", "-"} {
+			date := first + sep + second + sep + third
+			layouts = append(layouts, date+" 15:04:05", date+" 15:04", date)
+		}
+	}
+	return layouts
+}
+
+// isDateOrderLayout 判断格式是否由 DateOrder 启用
+func isDateOrderLayout(order DateOrder, layout string) bool {
+	for _, l := range dateOrderLayouts[order] {
+		if l == layout {
+			return true
+		}
+	}
+	return false
+}
+
+// ambiguousDayMonth 日与月互换后仍为不同的有效日期
+func ambiguousDayMonth(day, month int) bool {
+	return day != month && day >= 1 && day <= 12 && month >= 1 && month <= 12
+}
@@ -0,0 +1,286 @@
+package parse
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Epoch 纪元：以某一时刻为起点，按固定单位计数的时间表示法
+type Epoch struct {
+	name   string
+	origin time.Time
+	unit   time.Duration
+	gps    bool // 计数不含闰秒，需要按闰秒表换算为 UTC
+	ntp    bool // 计数为 32 位无符号整数，超出一个 NTP 纪元时报错
+}
+
+// NewEpoch 自定义纪元，origin 为计数 0 对应的时刻，unit 为每个计数的时长
+func NewEpoch(name string, origin time.Time, unit time.Duration) Epoch {
+	return Epoch{name: name, origin: origin, unit: unit}
+}
+
+var (
+	EpochUnix              = NewEpoch("Unix", time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC), time.Second)
+	EpochFileTime          = NewEpoch("FILETIME", time.Date(1601, 1, 1, 0, 0, 0, 0, time.UTC), 100*time.Nanosecond)
+	EpochDotNetTicks       = NewEpoch(".NET ticks", time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC), 100*time.Nanosecond)
+	EpochNTP               = NTPEra(0)
+	EpochGPS               = Epoch{name: "GPS", origin: time.Date(1980, 1, 6, 0, 0, 0, 0, time.UTC), unit: time.Second, gps: true}
+	EpochCocoa             = NewEpoch("Cocoa", time.Date(2001, 1, 1, 0, 0, 0, 0, time.UTC), time.Second)
+	EpochJulianDay         = NewEpoch("Julian Day", time.Date(-4713, 11, 24, 12, 0, 0, 0, time.UTC), 24*time.Hour)
+	EpochModifiedJulianDay = NewEpoch("Modified Julian Day", time.Date(1858, 11, 17, 0, 0, 0, 0, time.UTC), 24*time.Hour)
+)
+
+const (
+	// ntpEraSeconds 一个 NTP 纪元的秒数，NTP 时间戳的秒字段为 32 位无符号整数，约 136 年翻转一次
+	ntpEraSeconds = 1 << 32
+	// gpsWeekSeconds 一个 GPS 周的秒数
+	gpsWeekSeconds = 7 * 24 * 3600
+)
+
+// NTPEra NTP 第 era 纪元，第 0 纪元始于 1900-01-01，第 1 纪元始于 2036-02-07 06:28:16 UTC
+// 计数须在 0 至 4294967295 之间，超出时返回 ErrOutOfRange，不会自动推断纪元
+func NTPEra(era int) Epoch {
+	name := "NTP"
+	if era != 0 {
+		name = fmt.Sprintf("NTP era %d", era)
+	}
+	origin := time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC).Unix() + int64(era)*ntpEraSeconds
+	return Epoch{name: name, origin: time.Unix(origin, 0).UTC(), unit: time.Second, ntp: true}
+}
+
+// gpsLeapSeconds GPS 纪元之后各闰秒生效的 UTC 时刻
+var gpsLeapSeconds = []time.Time{
+	time.Date(1981, 7, 1, 0, 0, 0, 0, time.UTC), time.Date(1982, 7, 1, 0, 0, 0, 0, time.UTC),
+	time.Date(1983, 7, 1, 0, 0, 0, 0, time.UTC), time.Date(1985, 7, 1, 0, 0, 0, 0, time.UTC),
+	time.Date(1988, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC),
+	time.Date(1991, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(1992, 7, 1, 0, 0, 0, 0, time.UTC),
+	time.Date(1993, 7, 1, 0, 0, 0, 0, time.UTC), time.Date(1994, 7, 1, 0, 0, 0, 0, time.UTC),
+	time.Date(1996, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(1997, 7, 1, 0, 0, 0, 0, time.UTC),
+	time.Date(1999, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2006, 1, 1, 0, 0, 0, 0, time.UTC),
+	time.Date(2009, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2012, 7, 1, 0, 0, 0, 0, time.UTC),
+	time.Date(2015, 7, 1, 0, 0, 0, 0, time.UTC), time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC),
+}
+
+func (e Epoch) String() string {
+	return e.name
+}
+
+// FromEpoch 按纪元解析整数计数
+func FromEpoch(v any, opts ...func(*FromEpochOption)) (Result, error) {
+	cnf := newFromEpochOption(opts)
+	ticks, err := toInt64(v)
+	if err != nil {
+		return Result{}, err
+	}
+	return cnf.epoch.result(ticks, false, "")
+}
+
+// FromEpochString 按纪元解析数字形式的计数，如 "133264261350000000"、"2460057.265"
+// 小数部分按十进制精确换算，保留至纳秒
+func FromEpochString(s string, opts ...func(*FromEpochOption)) (Result, error) {
+	cnf := newFromEpochOption(opts)
+	intPart, fracPart, ok := splitNumeric(s)
+	if !ok {
+		return Result{}, newError(KindTimestamp, "", ErrUnsupportedExpression, "not a numeric %s value: %s", cnf.epoch, s)
+	}
+	ticks, err := strconv.ParseInt(intPart, 10, 64)
+	if err != nil {
+		return Result{}, newError(KindTimestamp, "value", ErrOutOfRange, "invalid %s value: %s", cnf.epoch, s)
+	}
+	return cnf.epoch.result(ticks, strings.HasPrefix(intPart, "-"), fracPart)
+}
+
+func newFromEpochOption(opts []func(*FromEpochOption)) *FromEpochOption {
+	cnf := &FromEpochOption{epoch: EpochUnix}
+	for _, opt := range opts {
+		opt(cnf)
+	}
+	return cnf
+}
+
+func (e Epoch) result(ticks int64, negative bool, frac string) (Result, error) {
+	at, err := e.Time(ticks, negative, frac)
+	if err != nil {
+		return Result{}, err
+	}
+
+	var unit Unit
+	switch e.unit {
+	case time.Second:
+		unit = UnitSecond
+	case time.Millisecond:
+		unit = UnitMillisecond
+	case time.Microsecond:
+		unit = UnitMicrosecond
+	case time.Nanosecond:
+		unit = UnitNanosecond
+	}
+	resolution := e.unit
+	for i := 0; i < len(frac) && resolution > 1; i++ {
+		resolution /= 10
+	}
+	return Result{Time: at, Kind: KindTimestamp, Unit: unit, Precision: durationPrecision(resolution)}, nil
+}
+
+// Time 将计数转换为时间，frac 为小数部分的数字，negative 表示计数为负数
+func (e Epoch) Time(ticks int64, negative bool, frac string) (time.Time, error) {
+	if err := e.validate(); err != nil {
+		return time.Time{}, err
+	}
+	if e.ntp && (negative || ticks < 0 || ticks >= ntpEraSeconds) {
+		return time.Time{}, newError(KindTimestamp, "value", ErrOutOfRange, "%s value %d exceeds 32 bits, use NTPEra for other eras", e, ticks)
+	}
+
+	// 拆分为秒及纳秒，避免 time.Duration 溢出（约 292 年）
+	var sec, nsec int64
+	if e.unit >= time.Second {
+		perTick := int64(e.unit / time.Second)
+		if ticks > math.MaxInt64/perTick || ticks < math.MinInt64/perTick {
+			return time.Time{}, newError(KindTimestamp, "value", ErrOutOfRange, "%s value %d", e, ticks)
+		}
+		sec = ticks * perTick
+	} else {
+		perSecond := int64(time.Second / e.unit)
+		sec, nsec = ticks/perSecond, ticks%perSecond*int64(e.unit)
+	}
+	if frac != "" {
+		fracNsec := roundRat(new(big.Rat).SetFrac(
+			new(big.Int).Mul(decimalDigits(frac), big.NewInt(int64(e.unit))),
+			new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(len(frac))), nil)))
+		if negative {
+			fracNsec = -fracNsec
+		}
+		nsec += fracNsec
+	}
+
+	origin := e.origin.Unix()
+	if sec > 0 && origin > math.MaxInt64-sec || sec < 0 && origin < math.MinInt64-sec {
+		return time.Time{}, newError(KindTimestamp, "value", ErrOutOfRange, "%s value %d", e, ticks)
+	}
+	at := time.Unix(origin+sec, int64(e.origin.Nanosecond())+nsec)
+	if e.gps {
+		at = at.Add(-time.Duration(gpsLeapCount(at, true)) * time.Second)
+	}
+	if sec := at.Unix(); sec < minPlausibleUnix || sec > maxPlausibleUnix {
+		return time.Time{}, newError(KindTimestamp, "value", ErrOutOfRange, "%s value %d", e, ticks)
+	}
+	return at, nil
+}
+
+// Ticks 将时间转换为计数，返回整数部分（向下取整）及不足一个单位的时长
+func (e Epoch) Ticks(t time.Time) (int64, time.Duration, error) {
+	if err := e.validate(); err != nil {
+		return 0, 0, err
+	}
+	if e.gps {
+		t = t.Add(time.Duration(gpsLeapCount(t, false)) * time.Second)
+	}
+
+	sec := t.Unix() - e.origin.Unix()
+	nsec := int64(t.Nanosecond()) - int64(e.origin.Nanosecond())
+	if nsec < 0 {
+		sec, nsec = sec-1, nsec+int64(time.Second)
+	}
+
+	if e.unit >= time.Second {
+		perTick := int64(e.unit / time.Second)
+		ticks := sec / perTick
+		rem := sec % perTick
+		if rem < 0 {
+			ticks, rem = ticks-1, rem+perTick
+		}
+		if e.ntp && (ticks < 0 || ticks >= ntpEraSeconds) {
+			return 0, 0, fmt.Errorf("%w: time %s out of %s range", ErrOutOfRange, t.Format(time.RFC3339), e)
+		}
+		return ticks, time.Duration(rem)*time.Second + time.Duration(nsec), nil
+	}
+
+	perSecond := int64(time.Second / e.unit)
+	if sec > math.MaxInt64/perSecond || sec < math.MinInt64/perSecond {
+		return 0, 0, fmt.Errorf("%w: time %s out of %s range", ErrOutOfRange, t.Format(time.RFC3339), e)
+	}
+	return sec*perSecond + nsec/int64(e.unit), time.Duration(nsec % int64(e.unit)), nil
+}
+
+// FromGPSWeek 按 GPS 周数及周内秒解析，如 2258、584553 为 2023-04-22 18:22:15 UTC
+// week 为自 1980-01-06 起的完整周数，不按 1024 周翻转；seconds 须在 [0, 604800) 之间
+func FromGPSWeek(week int, seconds float64) (Result, error) {
+	if week < 0 || int64(week) > math.MaxInt64/gpsWeekSeconds {
+		return Result{}, newError(KindTimestamp, "week", ErrOutOfRange, "GPS week %d", week)
+	}
+	if math.IsNaN(seconds) || seconds < 0 || seconds >= gpsWeekSeconds {
+		return Result{}, newError(KindTimestamp, "seconds", ErrOutOfRange, "GPS seconds of week %v", seconds)
+	}
+
+	// 小数部分按十进制数字换算，与数字形式的字符串一致
+	_, frac, _ := strings.Cut(strconv.FormatFloat(seconds, 'f', -1, 64), ".")
+	return EpochGPS.result(int64(week)*gpsWeekSeconds+int64(seconds), false, frac)
+}
+
+// GPSWeek 将时间转换为 GPS 周数及周内秒
+func GPSWeek(t time.Time) (int, float64, error) {
+	ticks, rem, err := EpochGPS.Ticks(t)
+	if err != nil {
+		return 0, 0, err
+	}
+	if ticks < 0 {
+		return 0, 0, fmt.Errorf("%w: time %s before %s epoch", ErrOutOfRange, t.Format(time.RFC3339), EpochGPS)
+	}
+	return int(ticks / gpsWeekSeconds), float64(ticks%gpsWeekSeconds) + rem.Seconds(), nil
+}
+
+// validate 单位须为整秒，或能整除一秒
+func (e Epoch) validate() error {
+	if e.unit <= 0 || (e.unit >= time.Second && e.unit%time.Second != 0) || (e.unit < time.Second && time.Second%e.unit != 0) {
+		return newError(KindTimestamp, "unit", ErrUnknownUnit, "%s unit %s", e, e.unit)
+	}
+	return nil
+}
+
+// Float 将时间转换为带小数的计数，适用于儒略日等以天为单位的纪元
+func (e Epoch) Float(t time.Time) (float64, error) {
+	ticks, rem, err := e.Ticks(t)
+	if err != nil {
+		return 0, err
+	}
+	return float64(ticks) + float64(rem)/float64(e.unit), nil
+}
+
+// gpsLeapCount GPS 时间与 UTC 之间相差的闰秒数，gps 表示 t 为 GPS 时间
+func gpsLeapCount(t time.Time, gps bool) int {
+	n := 0
+	for i, leap := range gpsLeapSeconds {
+		if gps {
+			leap = leap.Add(time.Duration(i+1) * time.Second)
+		}
+		if t.Before(leap) {
+			break
+		}
+		n++
+	}
+	return n
+}
+
+// durationPrecision 时长对应的精度
+func durationPrecision(d time.Duration) Precision {
+	switch {
+	case d >= 24*time.Hour:
+		return PrecisionDay
+	case d >= time.Hour:
+		return PrecisionHour
+	case d >= time.Minute:
+		return PrecisionMinute
+	case d >= time.Second:
+		return PrecisionSecond
+	case d >= time.Millisecond:
+		return PrecisionMillisecond
+	case d >= time.Microsecond:
+		return PrecisionMicrosecond
+	default:
+		return PrecisionNanosecond
+	}
+}
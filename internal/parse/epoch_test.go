@@ -0,0 +1,153 @@
+package parse_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/gomooth/chronos/internal/parse"
+)
+
+func TestFromEpochString(t *testing.T) {
+	tests := []struct {
+		name      string
+		epoch     parse.Epoch
+		value     string
+		expected  time.Time
+		precision parse.Precision
+		wantErr   bool
+	}{
+		{"filetime", parse.EpochFileTime, "133266613350000000", time.Date(2023, 4, 22, 18, 22, 15, 0, time.UTC), parse.PrecisionNanosecond, false},
+		{".net ticks", parse.EpochDotNetTicks, "638177845350000000", time.Date(2023, 4, 22, 18, 22, 15, 0, time.UTC), parse.PrecisionNanosecond, false},
+		{"ntp", parse.EpochNTP, "3891176535", time.Date(2023, 4, 22, 18, 22, 15, 0, time.UTC), parse.PrecisionSecond, false},
+		{"ntp fraction", parse.EpochNTP, "3891176535.25", time.Date(2023, 4, 22, 18, 22, 15, 250000000, time.UTC), parse.PrecisionMillisecond, false},
+		{"gps with leap seconds", parse.EpochGPS, "1366222953", time.Date(2023, 4, 22, 18, 22, 15, 0, time.UTC), parse.PrecisionSecond, false},
+		{"gps before first leap second", parse.EpochGPS, "86400", time.Date(1980, 1, 7, 0, 0, 0, 0, time.UTC), parse.PrecisionSecond, false},
+		{"cocoa", parse.EpochCocoa, "703880535", time.Date(2023, 4, 22, 18, 22, 15, 0, time.UTC), parse.PrecisionSecond, false},
+		{"cocoa negative", parse.EpochCocoa, "-86400.5", time.Date(2000, 12, 30, 23, 59, 59, 500000000, time.UTC), parse.PrecisionMillisecond, false},
+		{"julian day", parse.EpochJulianDay, "2460057", time.Date(2023, 4, 22, 12, 0, 0, 0, time.UTC), parse.PrecisionDay, false},
+		{"julian day fraction", parse.EpochJulianDay, "2460056.5", time.Date(2023, 4, 22, 0, 0, 0, 0, time.UTC), parse.PrecisionHour, false},
+		{"modified julian day", parse.EpochModifiedJulianDay, "60056.75", time.Date(2023, 4, 22, 18, 0, 0, 0, time.UTC), parse.PrecisionMinute, false},
+		{"unix", parse.EpochUnix, "1682187735", time.Date(2023, 4, 22, 18, 22, 15, 0, time.UTC), parse.PrecisionSecond, false},
+		{"custom", parse.NewEpoch("ms since 2000", time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), time.Millisecond), "1500", time.Date(2000, 1, 1, 0, 0, 1, 500000000, time.UTC), parse.PrecisionMillisecond, false},
+
+		{"julian day 0 out of range", parse.EpochJulianDay, "0", time.Time{}, 0, true},
+		{"overflow", parse.EpochJulianDay, "9223372036854775807", time.Time{}, 0, true},
+		{"invalid unit", parse.NewEpoch("bad", time.Time{}, 3*time.Nanosecond), "1", time.Time{}, 0, true},
+		{"not numeric", parse.EpochNTP, "abc", time.Time{}, 0, true},
+		{"ntp era 1", parse.NTPEra(1), "0", time.Date(2036, 2, 7, 6, 28, 16, 0, time.UTC), parse.PrecisionSecond, false},
+		{"ntp era 1 after rollover", parse.NTPEra(1), "1000", time.Date(2036, 2, 7, 6, 44, 56, 0, time.UTC), parse.PrecisionSecond, false},
+		{"ntp exceeds 32 bits", parse.EpochNTP, "4294967296", time.Time{}, 0, true},
+		{"ntp negative", parse.EpochNTP, "-0.5", time.Time{}, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parse.FromEpochString(tt.value, parse.WithFromEpoch(tt.epoch))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FromEpochString(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !got.Equal(tt.expected) {
+				t.Errorf("FromEpochString(%q) = %v, want %v", tt.value, got.Time.UTC(), tt.expected)
+			}
+			if got.Precision != tt.precision {
+				t.Errorf("FromEpochString(%q) precision = %v, want %v", tt.value, got.Precision, tt.precision)
+			}
+		})
+	}
+}
+
+func TestEpochTicks(t *testing.T) {
+	at := time.Date(2023, 4, 22, 18, 22, 15, 0, time.UTC)
+	tests := []struct {
+		epoch parse.Epoch
+		ticks int64
+		float float64
+	}{
+		{parse.EpochFileTime, 133266613350000000, 133266613350000000},
+		{parse.EpochDotNetTicks, 638177845350000000, 638177845350000000},
+		{parse.EpochNTP, 3891176535, 3891176535},
+		{parse.EpochGPS, 1366222953, 1366222953},
+		{parse.EpochCocoa, 703880535, 703880535},
+		{parse.EpochModifiedJulianDay, 60056, 60056.765451388885},
+		{parse.EpochJulianDay, 2460057, 2460057.265451389},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.epoch.String(), func(t *testing.T) {
+			ticks, _, err := tt.epoch.Ticks(at)
+			if err != nil || ticks != tt.ticks {
+				t.Errorf("Ticks() = %d, %v, want %d", ticks, err, tt.ticks)
+			}
+			f, err := tt.epoch.Float(at)
+			if err != nil || math.Abs(f-tt.float) > 1e-6 {
+				t.Errorf("Float() = %v, %v, want %v", f, err, tt.float)
+			}
+
+			// 往返转换
+			back, err := tt.epoch.Time(ticks, false, "")
+			if err != nil || back.After(at) || at.Sub(back) >= 24*time.Hour {
+				t.Errorf("Time(%d) = %v, %v, want at most %v", ticks, back, err, at)
+			}
+		})
+	}
+}
+
+func TestNTPEraTicks(t *testing.T) {
+	at := time.Date(2040, 1, 1, 0, 0, 0, 0, time.UTC)
+	if _, _, err := parse.EpochNTP.Ticks(at); !errors.Is(err, parse.ErrOutOfRange) {
+		t.Errorf("EpochNTP.Ticks(%v) error = %v, want ErrOutOfRange", at, err)
+	}
+	ticks, _, err := parse.NTPEra(1).Ticks(at)
+	if err != nil || ticks != 123010304 {
+		t.Errorf("NTPEra(1).Ticks(%v) = %d, %v, want 123010304", at, ticks, err)
+	}
+}
+
+func TestFromGPSWeek(t *testing.T) {
+	tests := []struct {
+		name      string
+		week      int
+		seconds   float64
+		expected  time.Time
+		precision parse.Precision
+		wantErr   bool
+	}{
+		{"week and seconds", 2258, 584553, time.Date(2023, 4, 22, 18, 22, 15, 0, time.UTC), parse.PrecisionSecond, false},
+		{"fraction", 2258, 584553.25, time.Date(2023, 4, 22, 18, 22, 15, 250000000, time.UTC), parse.PrecisionMillisecond, false},
+		{"first week", 0, 86400, time.Date(1980, 1, 7, 0, 0, 0, 0, time.UTC), parse.PrecisionSecond, false},
+
+		{"negative week", -1, 0, time.Time{}, 0, true},
+		{"seconds beyond week", 2258, 604800, time.Time{}, 0, true},
+		{"negative seconds", 2258, -1, time.Time{}, 0, true},
+		{"nan seconds", 2258, math.NaN(), time.Time{}, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parse.FromGPSWeek(tt.week, tt.seconds)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FromGPSWeek(%d, %v) error = %v, wantErr %v", tt.week, tt.seconds, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if !errors.Is(err, parse.ErrOutOfRange) {
+					t.Errorf("FromGPSWeek(%d, %v) error = %v, want ErrOutOfRange", tt.week, tt.seconds, err)
+				}
+				return
+			}
+			if !got.Equal(tt.expected) || got.Precision != tt.precision {
+				t.Errorf("FromGPSWeek(%d, %v) = %v (%v), want %v (%v)", tt.week, tt.seconds, got.Time.UTC(), got.Precision, tt.expected, tt.precision)
+			}
+
+			// 往返转换
+			week, seconds, err := parse.GPSWeek(got.Time)
+			if err != nil || week != tt.week || seconds != tt.seconds {
+				t.Errorf("GPSWeek(%v) = %d, %v, %v, want %d, %v", got.Time, week, seconds, err, tt.week, tt.seconds)
+			}
+		})
+	}
+}
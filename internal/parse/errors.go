@@ -0,0 +1,66 @@
+package parse
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gomooth/chronos/internal/helper"
+)
+
+var (
+	// ErrUnsupportedExpression 输入无法识别
+	ErrUnsupportedExpression = errors.New("unsupported time expression")
+	// ErrAmbiguous 输入存在多种有效解读
+	ErrAmbiguous = errors.New("ambiguous time string")
+	// ErrOutOfRange 输入可以识别，但某一部分超出有效范围，如 2 月 30 日、25 点
+	ErrOutOfRange = errors.New("time value out of range")
+	// ErrUnknownUnit 未知的时间单位、时间戳单位或日期系统
+	ErrUnknownUnit = errors.New("unknown time unit")
+)
+
+// ParseError 时间解析错误
+type ParseError struct {
+	Input     string   // 原始输入
+	Kind      Kind     // 出错时按哪种类型解析，无法确定时为 KindUnknown
+	Layouts   []string // 尝试过的格式，仅按格式解析时有值
+	Component string   // 出错的部分，如 "month"、"hour"、"zone"、"unit"，无法确定时为空
+	Err       error    // 底层错误，可通过 errors.Is 判断是否为 ErrOutOfRange 等
+}
+
+func (e *ParseError) Error() string {
+	if e.Input == "" {
+		return e.Err.Error()
+	}
+	return fmt.Sprintf("parsing time %q: %s", e.Input, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// newError 构造解析错误，sentinel 不为 nil 时可通过 errors.Is 识别
+func newError(kind Kind, component string, sentinel error, format string, args ...any) *ParseError {
+	err := fmt.Errorf(format, args...)
+	if sentinel != nil {
+		err = fmt.Errorf("%w: %w", sentinel, err)
+	}
+	return &ParseError{Kind: kind, Component: component, Err: err}
+}
+
+// outOfRange 返回超出范围的字段名称，均有效时返回空字符串
+func outOfRange(year int, month time.Month, day, hour, minute, second int) string {
+	switch {
+	case month < time.January || month > time.December:
+		return "month"
+	case day < 1 || day > helper.DaysInMonth(year, month):
+		return "day"
+	case hour < 0 || hour > 23:
+		return "hour"
+	case minute < 0 || minute > 59:
+		return "minute"
+	case second < 0 || second > 59:
+		return "second"
+	}
+	return ""
+}
@@ -0,0 +1,52 @@
+package parse_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gomooth/chronos/internal/parse"
+)
+
+func TestParseError(t *testing.T) {
+	tests := []struct {
+		name      string
+		parse     func() (parse.Result, error)
+		target    error
+		kind      parse.Kind
+		component string
+	}{
+		{"layout month", func() (parse.Result, error) { return parse.FromStringFormat("2023-13-01") }, parse.ErrOutOfRange, parse.KindLayout, "month"},
+		{"layout structure mismatch", func() (parse.Result, error) { return parse.FromStringFormat("31/12/2023") }, parse.ErrUnsupportedExpression, parse.KindLayout, ""},
+		{"layout clock mismatch", func() (parse.Result, error) { return parse.FromStringFormat("16:75") }, parse.ErrUnsupportedExpression, parse.KindLayout, ""},
+		{"iso ordinal day", func() (parse.Result, error) { return parse.FromStringFormat("2023-366") }, parse.ErrOutOfRange, parse.KindISO8601, "day"},
+		{"iso week", func() (parse.Result, error) { return parse.ParseISO8601("2023-W54", time.UTC) }, parse.ErrOutOfRange, parse.KindISO8601, "week"},
+		{"iso minute", func() (parse.Result, error) { return parse.ParseISO8601("2023-04-22T18:60", time.UTC) }, parse.ErrOutOfRange, parse.KindISO8601, "minute"},
+		{"iso zone", func() (parse.Result, error) { return parse.ParseISO8601("2023-04-22T18:22+25:00", time.UTC) }, parse.ErrOutOfRange, parse.KindISO8601, "zone"},
+		{"tokens hour", func() (parse.Result, error) { return parse.FromTokens("April 22 2023 13pm") }, parse.ErrOutOfRange, parse.KindTokens, "hour"},
+		{"tokens unknown", func() (parse.Result, error) { return parse.FromTokens("April 22 2023 foo") }, parse.ErrUnsupportedExpression, parse.KindTokens, "token"},
+		{"natural language unsupported", func() (parse.Result, error) { return parse.FromNaturalLanguage("sometime soon") }, parse.ErrUnsupportedExpression, parse.KindNaturalLanguage, ""},
+		{"natural language unit", func() (parse.Result, error) { return parse.FromNaturalLanguage("2 decades ago") }, parse.ErrUnknownUnit, parse.KindNaturalLanguage, "unit"},
+		{"chinese hour", func() (parse.Result, error) { return parse.FromChinese("上午13点") }, parse.ErrOutOfRange, parse.KindChinese, "hour"},
+		{"keyword", func() (parse.Result, error) { return parse.FromKeyword("someday") }, parse.ErrUnsupportedExpression, parse.KindKeyword, ""},
+		{"zone abbreviation", func() (parse.Result, error) {
+			return parse.FromStringFormat("2023-04-22 18:22 CST", parse.WithFromStringLayout("2006-01-02 15:04 MST"), parse.WithFromStringStrict(true))
+		}, parse.ErrAmbiguous, parse.KindLayout, "zone"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.parse()
+			if !errors.Is(err, tt.target) {
+				t.Fatalf("error = %v, want %v", err, tt.target)
+			}
+			var pe *parse.ParseError
+			if !errors.As(err, &pe) {
+				t.Fatalf("error %v is not a ParseError", err)
+			}
+			if pe.Kind != tt.kind || pe.Component != tt.component {
+				t.Errorf("kind = %s, component = %q, want %s, %q", pe.Kind, pe.Component, tt.kind, tt.component)
+			}
+		})
+	}
+}
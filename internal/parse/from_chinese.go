@@ -0,0 +1,252 @@
+package parse
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+var chineseRe = regexp.MustCompile(`^` +
+	// 日期：相对日期，或 年、月、日 的任意后缀组合
+	`(?:(大前天|前天|昨天|今天|明天|后天|大后天)|(?:(\d{4}|\d{2})年)?(?:(\d{1,2})月)?(?:(\d{1,2})[日号])?)` +
+	// 星期
+	`(?:(?:星期|礼拜|周)([1-6日天]))?` +
+	// 时间：时段 + 时[分[秒]]、半、一刻、三刻，或 hh:mm[:ss]
+	`(?:(凌晨|早上|早晨|上午|中午|下午|傍晚|晚上|夜里)?(\d{1,2})(?:[点时](?:(半|[13]刻)|(\d{1,2})(?:分(?:(\d{1,2})秒)?)?)?|:(\d{2})(?::(\d{2}))?))?` +
+	`$`)
+
+// chineseRelativeDays 相对日期与基准日期相差的天数
+var chineseRelativeDays = map[string]int{
+	"大前天": -3, "前天": -2, "昨天": -1, "今天": 0, "明天": 1, "后天": 2, "大后天": 3,
+}
+
+var chineseDigits = map[rune]int{
+	'〇': 0, '零': 0, '一': 1, '二': 2, '两': 2, '三': 3, '四': 4, '五': 5, '六': 6, '七': 7, '八': 8, '九': 9,
+}
+
+// FromChinese 解析中文日期时间
+// 支持格式如: "2023年04月22日", "2023年4月22日 18时22分15秒", "4月22日", "下午3点15分", "晚上8点半",
+// "二〇二三年四月二十二日", "明天上午10点"
+// 缺失的日期字段取基准时间
+func FromChinese(s string, opts ...func(*FromChineseOption)) (Result, error) {
+	cnf := new(FromChineseOption)
+	for _, opt := range opts {
+		opt(cnf)
+	}
+
+	normalized := normalizeChinese(s)
+	m := chineseRe.FindStringSubmatch(normalized)
+	if m == nil || normalized == "" || !hasHan(s) {
+		return Result{}, newError(KindChinese, "", ErrUnsupportedExpression, "not a Chinese time expression")
+	}
+
+	base := time.Now()
+	if cnf.baseTime != nil {
+		base = *cnf.baseTime
+	}
+	loc := time.Local
+	if cnf.loc != nil {
+		loc = cnf.loc
+	}
+	base = base.In(loc)
+
+	// 日期
+	precision := PrecisionYear
+	year, month, day := base.Date()
+	switch {
+	case m[1] != "":
+		year, month, day = base.AddDate(0, 0, chineseRelativeDays[m[1]]).Date()
+		precision = PrecisionDay
+	default:
+		if m[2] != "" {
+			year, _ = strconv.Atoi(m[2])
+			if len(m[2]) == 2 {
+				year = cnf.twoDigitYear.Resolve(year, base)
+			}
+			month, day = time.January, 1
+		}
+		if m[3] != "" {
+			v, _ := strconv.Atoi(m[3])
+			month, day, precision = time.Month(v), 1, PrecisionMonth
+		}
+		if m[4] != "" {
+			day, _ = strconv.Atoi(m[4])
+			precision = PrecisionDay
+		}
+	}
+	if component := outOfRange(year, month, day, 0, 0, 0); component != "" {
+		return Result{}, newError(KindChinese, component, ErrOutOfRange, "%04d-%02d-%02d", year, month, day)
+	}
+
+	// 时间
+	hour, minute, second := 0, 0, 0
+	if m[7] != "" {
+		hour, _ = strconv.Atoi(m[7])
+		precision = PrecisionHour
+		switch {
+		case m[8] == "半":
+			minute, precision = 30, PrecisionMinute
+		case m[8] != "":
+			minute, precision = int(m[8][0]-'0')*15, PrecisionMinute
+		case m[9] != "" || m[11] != "":
+			minute, _ = strconv.Atoi(m[9] + m[11])
+			precision = PrecisionMinute
+		}
+		if m[10] != "" || m[12] != "" {
+			second, _ = strconv.Atoi(m[10] + m[12])
+			precision = PrecisionSecond
+		}
+
+		var err error
+		if hour, err = chineseHour(m[6], hour); err != nil {
+			return Result{}, err
+		}
+		if component := outOfRange(year, month, day, hour%24, minute, second); component != "" || (hour == 24 && minute+second > 0) {
+			if component == "" {
+				component = "hour"
+			}
+			return Result{}, newError(KindChinese, component, ErrOutOfRange, "%02d:%02d:%02d", hour, minute, second)
+		}
+	} else if m[6] != "" {
+		return Result{}, newError(KindChinese, "hour", ErrUnsupportedExpression, "missing hour after %s", m[6])
+	}
+
+	at := time.Date(year, month, day, hour, minute, second, 0, loc)
+	if m[5] != "" {
+		weekday := time.Weekday(0)
+		if m[5] != "日" && m[5] != "天" {
+			weekday = time.Weekday(m[5][0] - '0')
+		}
+		if w := time.Date(year, month, day, 0, 0, 0, 0, loc).Weekday(); w != weekday {
+			return Result{}, newError(KindChinese, "weekday", ErrOutOfRange, "weekday %s does not match date %s", weekday, at.Format(time.DateOnly))
+		}
+	}
+
+	return Result{Time: at, Kind: KindChinese, Precision: precision}, nil
+}
+
+// chineseHour 按时段换算为 24 小时制
+// 晚上、夜里 12 点视为次日零点
+func chineseHour(period string, hour int) (int, error) {
+	switch period {
+	case "":
+		if hour > 23 {
+			return 0, newError(KindChinese, "hour", ErrOutOfRange, "invalid hour: %d", hour)
+		}
+	case "凌晨":
+		if hour > 12 {
+			return 0, newError(KindChinese, "hour", ErrOutOfRange, "invalid hour for %s: %d", period, hour)
+		}
+		if hour == 12 {
+			hour = 0
+		}
+	case "早上", "早晨", "上午":
+		if hour > 12 {
+			return 0, newError(KindChinese, "hour", ErrOutOfRange, "invalid hour for %s: %d", period, hour)
+		}
+	case "中午":
+		if hour > 14 {
+			return 0, newError(KindChinese, "hour", ErrOutOfRange, "invalid hour for %s: %d", period, hour)
+		}
+		if hour < 11 {
+			hour += 12
+		}
+	case "晚上", "夜里":
+		if hour > 23 {
+			return 0, newError(KindChinese, "hour", ErrOutOfRange, "invalid hour for %s: %d", period, hour)
+		}
+		if hour <= 12 {
+			hour += 12
+		}
+	default: // 下午、傍晚
+		if hour > 23 {
+			return 0, newError(KindChinese, "hour", ErrOutOfRange, "invalid hour for %s: %d", period, hour)
+		}
+		if hour < 12 {
+			hour += 12
+		}
+	}
+	return hour, nil
+}
+
+// normalizeChinese 去除空白，将全角数字、全角冒号及中文数字转换为阿拉伯数字
+// "星期一" 转换为 "星期1"，由正则识别为星期；含 "十" 的数字按位值换算，如 "二十二" => 22，其余逐位转换，如 "二〇二三" => 2023
+func normalizeChinese(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	runes := []rune(s)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			continue
+		case r >= '０' && r <= '９':
+			b.WriteRune('0' + r - '０')
+			continue
+		case r == '：':
+			b.WriteByte(':')
+			continue
+		}
+
+		_, digit := chineseDigits[r]
+		if !digit && r != '十' {
+			b.WriteRune(r)
+			continue
+		}
+		j := i
+		for j < len(runes) && (runes[j] == '十' || isChineseDigit(runes[j])) {
+			j++
+		}
+		b.WriteString(chineseNumber(runes[i:j]))
+		i = j - 1
+	}
+	return b.String()
+}
+
+func isChineseDigit(r rune) bool {
+	_, ok := chineseDigits[r]
+	return ok
+}
+
+// chineseNumber 转换连续的中文数字
+func chineseNumber(rs []rune) string {
+	ten := -1
+	for i, r := range rs {
+		if r == '十' {
+			ten = i
+			break
+		}
+	}
+	if ten < 0 {
+		var b strings.Builder
+		for _, r := range rs {
+			b.WriteByte(byte('0' + chineseDigits[r]))
+		}
+		return b.String()
+	}
+
+	tens, ones := 1, 0
+	if ten > 0 {
+		tens = chineseDigits[rs[ten-1]]
+	}
+	if ten+1 < len(rs) {
+		ones = chineseDigits[rs[ten+1]]
+	}
+	if ten > 1 || ten+2 < len(rs) {
+		// 十 前后多于一位数字，不是合法的日期数字，原样保留
+		return string(rs)
+	}
+	return strconv.Itoa(tens*10 + ones)
+}
+
+// hasHan 输入中是否含有汉字
+func hasHan(s string) bool {
+	for _, r := range s {
+		if unicode.Is(unicode.Han, r) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,30 @@
+package parse
+
+import "time"
+
+type FromChineseOption struct {
+	baseTime *time.Time
+	loc      *time.Location
+
+	twoDigitYear TwoDigitYear
+}
+
+func WithFromChineseBaseTime(base time.Time) func(*FromChineseOption) {
+	return func(o *FromChineseOption) {
+		if !base.IsZero() {
+			o.baseTime = &base
+		}
+	}
+}
+
+func WithFromChineseLocation(loc *time.Location) func(*FromChineseOption) {
+	return func(o *FromChineseOption) {
+		o.loc = loc
+	}
+}
+
+func WithFromChineseTwoDigitYear(rule TwoDigitYear) func(*FromChineseOption) {
+	return func(o *FromChineseOption) {
+		o.twoDigitYear = rule
+	}
+}
@@ -0,0 +1,92 @@
+package parse_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gomooth/chronos/internal/parse"
+)
+
+func TestFromChinese(t *testing.T) {
+	// 2023-05-15 为星期一
+	baseTime := time.Date(2023, 5, 15, 12, 0, 0, 0, time.UTC)
+	withBase := []func(*parse.FromChineseOption){parse.WithFromChineseBaseTime(baseTime), parse.WithFromChineseLocation(time.UTC)}
+
+	tests := []struct {
+		name      string
+		expr      string
+		expected  time.Time
+		precision parse.Precision
+		wantErr   bool
+	}{
+		// 完整日期
+		{"date", "2023年04月22日", time.Date(2023, 4, 22, 0, 0, 0, 0, time.UTC), parse.PrecisionDay, false},
+		{"date with hao", "2023年4月22号", time.Date(2023, 4, 22, 0, 0, 0, 0, time.UTC), parse.PrecisionDay, false},
+		{"date time", "2023年4月22日 18时22分15秒", time.Date(2023, 4, 22, 18, 22, 15, 0, time.UTC), parse.PrecisionSecond, false},
+		{"date colon time", "2023年4月22日 18:22:15", time.Date(2023, 4, 22, 18, 22, 15, 0, time.UTC), parse.PrecisionSecond, false},
+		{"date weekday time", "2023年4月22日 星期六 下午6点22分", time.Date(2023, 4, 22, 18, 22, 0, 0, time.UTC), parse.PrecisionMinute, false},
+		{"two digit year", "23年4月22日", time.Date(2023, 4, 22, 0, 0, 0, 0, time.UTC), parse.PrecisionDay, false},
+		{"year month", "2023年4月", time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC), parse.PrecisionMonth, false},
+		{"year", "2023年", time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), parse.PrecisionYear, false},
+		{"full width digits", "２０２３年４月２２日", time.Date(2023, 4, 22, 0, 0, 0, 0, time.UTC), parse.PrecisionDay, false},
+
+		// 中文数字
+		{"chinese numerals", "二〇二三年四月二十二日", time.Date(2023, 4, 22, 0, 0, 0, 0, time.UTC), parse.PrecisionDay, false},
+		{"chinese zero", "二零二三年十二月十日", time.Date(2023, 12, 10, 0, 0, 0, 0, time.UTC), parse.PrecisionDay, false},
+		{"chinese time", "四月二十二日下午三点十五分", time.Date(2023, 4, 22, 15, 15, 0, 0, time.UTC), parse.PrecisionMinute, false},
+		{"liang", "两点", time.Date(2023, 5, 15, 2, 0, 0, 0, time.UTC), parse.PrecisionHour, false},
+
+		// 缺省日期取基准时间
+		{"month day", "4月22日", time.Date(2023, 4, 22, 0, 0, 0, 0, time.UTC), parse.PrecisionDay, false},
+		{"day", "22日", time.Date(2023, 5, 22, 0, 0, 0, 0, time.UTC), parse.PrecisionDay, false},
+		{"pm time", "下午3点15分", time.Date(2023, 5, 15, 15, 15, 0, 0, time.UTC), parse.PrecisionMinute, false},
+		{"half", "晚上8点半", time.Date(2023, 5, 15, 20, 30, 0, 0, time.UTC), parse.PrecisionMinute, false},
+		{"quarter", "上午9点一刻", time.Date(2023, 5, 15, 9, 15, 0, 0, time.UTC), parse.PrecisionMinute, false},
+		{"three quarters", "9点三刻", time.Date(2023, 5, 15, 9, 45, 0, 0, time.UTC), parse.PrecisionMinute, false},
+		{"minute without suffix", "3点05", time.Date(2023, 5, 15, 3, 5, 0, 0, time.UTC), parse.PrecisionMinute, false},
+		{"weekday", "星期一 10点", time.Date(2023, 5, 15, 10, 0, 0, 0, time.UTC), parse.PrecisionHour, false},
+
+		// 时段
+		{"early morning 12", "凌晨12点", time.Date(2023, 5, 15, 0, 0, 0, 0, time.UTC), parse.PrecisionHour, false},
+		{"noon", "中午12点", time.Date(2023, 5, 15, 12, 0, 0, 0, time.UTC), parse.PrecisionHour, false},
+		{"noon 1", "中午1点", time.Date(2023, 5, 15, 13, 0, 0, 0, time.UTC), parse.PrecisionHour, false},
+		{"night 12", "晚上12点", time.Date(2023, 5, 16, 0, 0, 0, 0, time.UTC), parse.PrecisionHour, false},
+		{"pm 24 hour", "下午18点", time.Date(2023, 5, 15, 18, 0, 0, 0, time.UTC), parse.PrecisionHour, false},
+
+		// 相对日期
+		{"tomorrow", "明天上午10点", time.Date(2023, 5, 16, 10, 0, 0, 0, time.UTC), parse.PrecisionHour, false},
+		{"day before yesterday", "前天", time.Date(2023, 5, 13, 0, 0, 0, 0, time.UTC), parse.PrecisionDay, false},
+
+		// 异常情况
+		{"empty", "", time.Time{}, 0, true},
+		{"no chinese", "18:22", time.Time{}, 0, true},
+		{"invalid day", "2023年2月30日", time.Time{}, 0, true},
+		{"invalid month", "2023年13月", time.Time{}, 0, true},
+		{"invalid am hour", "上午13点", time.Time{}, 0, true},
+		{"invalid hour", "25点", time.Time{}, 0, true},
+		{"weekday mismatch", "2023年4月22日 星期五", time.Time{}, 0, true},
+		{"period without hour", "下午", time.Time{}, 0, true},
+		{"unknown words", "下个月", time.Time{}, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parse.FromChinese(tt.expr, withBase...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FromChinese(%q) error = %v, wantErr %v", tt.expr, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !got.Time.Equal(tt.expected) {
+				t.Errorf("FromChinese(%q) = %v, want %v", tt.expr, got.Time, tt.expected)
+			}
+			if got.Kind != parse.KindChinese {
+				t.Errorf("FromChinese(%q) kind = %v, want %v", tt.expr, got.Kind, parse.KindChinese)
+			}
+			if got.Precision != tt.precision {
+				t.Errorf("FromChinese(%q) precision = %v, want %v", tt.expr, got.Precision, tt.precision)
+			}
+		})
+	}
+}
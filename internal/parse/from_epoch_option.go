@@ -0,0 +1,11 @@
+package parse
+
+type FromEpochOption struct {
+	epoch Epoch
+}
+
+func WithFromEpoch(epoch Epoch) func(*FromEpochOption) {
+	return func(o *FromEpochOption) {
+		o.epoch = epoch
+	}
+}
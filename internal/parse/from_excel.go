@@ -0,0 +1,181 @@
+package parse
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ExcelSystem 电子表格的日期系统
+type ExcelSystem int
+
+const (
+	ExcelSystemNone ExcelSystem = iota
+	ExcelSystem1900             // 1900 日期系统，序列号 1 为 1900-01-01，沿用 Lotus 1-2-3 将 1900 年视为闰年的错误
+	ExcelSystem1904             // 1904 日期系统，序列号 0 为 1904-01-01
+)
+
+func (s ExcelSystem) String() string {
+	switch s {
+	case ExcelSystem1900:
+		return "1900"
+	case ExcelSystem1904:
+		return "1904"
+	default:
+		return "none"
+	}
+}
+
+var (
+	excelEpoch1900      = time.Date(1899, time.December, 30, 0, 0, 0, 0, time.UTC) // 1900-03-01 及之后
+	excelEpoch1900Early = time.Date(1899, time.December, 31, 0, 0, 0, 0, time.UTC) // 1900-03-01 之前
+	excelEpoch1904      = time.Date(1904, time.January, 1, 0, 0, 0, 0, time.UTC)
+	excelMarch1900      = time.Date(1900, time.March, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// excelLeapBugSerial 1900 日期系统中并不存在的 1900-02-29
+const excelLeapBugSerial = 60
+
+// FromExcelSerial 解析整数形式的电子表格序列号
+func FromExcelSerial(v any, opts ...func(*FromExcelOption)) (Result, error) {
+	cnf := newFromExcelOption(opts)
+	days, err := toInt64(v)
+	if err != nil {
+		return Result{}, err
+	}
+	return fromExcel(days, "", cnf)
+}
+
+// FromExcelSerialString 解析数字形式的电子表格序列号，如 "45038"、"45038.765"
+// 整数部分为天数，小数部分为一天中的时间，按毫秒四舍五入
+func FromExcelSerialString(s string, opts ...func(*FromExcelOption)) (Result, error) {
+	cnf := newFromExcelOption(opts)
+	intPart, fracPart, ok := splitNumeric(s)
+	if !ok {
+		return Result{}, newError(KindExcelSerial, "", ErrUnsupportedExpression, "not a numeric serial: %s", s)
+	}
+	days, err := strconv.ParseInt(intPart, 10, 64)
+	if err != nil || strings.HasPrefix(intPart, "-") {
+		return Result{}, newError(KindExcelSerial, "value", ErrOutOfRange, "invalid excel serial: %s", s)
+	}
+	return fromExcel(days, fracPart, cnf)
+}
+
+// IsNumericString 是否为 [+-]digits[.digits] 形式的字符串
+func IsNumericString(s string) bool {
+	_, _, ok := splitNumeric(s)
+	return ok
+}
+
+func newFromExcelOption(opts []func(*FromExcelOption)) *FromExcelOption {
+	cnf := &FromExcelOption{system: ExcelSystem1900}
+	for _, opt := range opts {
+		opt(cnf)
+	}
+	return cnf
+}
+
+// fromExcel 将天数及一天中的小数部分转换为指定时区的墙上时间
+func fromExcel(days int64, frac string, cnf *FromExcelOption) (Result, error) {
+	if days < 0 || days > 3e6 {
+		return Result{}, newError(KindExcelSerial, "value", ErrOutOfRange, "excel serial %d", days)
+	}
+
+	var epoch time.Time
+	switch cnf.system {
+	case ExcelSystem1900:
+		switch {
+		case days == excelLeapBugSerial:
+			return Result{}, newError(KindExcelSerial, "day", ErrOutOfRange, "excel serial %d is 1900-02-29, which does not exist", days)
+		case days < excelLeapBugSerial:
+			epoch = excelEpoch1900Early
+		default:
+			epoch = excelEpoch1900
+		}
+	case ExcelSystem1904:
+		epoch = excelEpoch1904
+	default:
+		return Result{}, newError(KindExcelSerial, "system", ErrUnknownUnit, "excel date system %d", cnf.system)
+	}
+
+	date := epoch.AddDate(0, 0, int(days))
+	precision := PrecisionDay
+	var clock time.Duration
+	if frac != "" {
+		// 按十进制精确计算毫秒数，避免浮点误差
+		ms := new(big.Rat).SetFrac(new(big.Int).Mul(decimalDigits(frac), big.NewInt(86400000)),
+			new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(len(frac))), nil))
+		clock = time.Duration(roundRat(ms)) * time.Millisecond
+		precision = excelPrecision(len(frac))
+	}
+	if date.Add(clock).Year() > 9999 {
+		return Result{}, newError(KindExcelSerial, "value", ErrOutOfRange, "excel serial %d", days)
+	}
+
+	loc := time.Local
+	if cnf.loc != nil {
+		loc = cnf.loc
+	}
+	// 以纳秒溢出的方式构造墙上时间，避免夏令时切换日按绝对时长相加产生偏差
+	y, m, d := date.Date()
+	at := time.Date(y, m, d, 0, 0, 0, int(clock), loc)
+	return Result{Time: at, Kind: KindExcelSerial, Precision: precision}, nil
+}
+
+// decimalDigits 将数字字符串转换为大整数
+func decimalDigits(s string) *big.Int {
+	v, _ := new(big.Int).SetString(s, 10)
+	return v
+}
+
+// roundRat 四舍五入为整数
+func roundRat(r *big.Rat) int64 {
+	q, m := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+	if new(big.Int).Mul(m, big.NewInt(2)).Cmp(r.Denom()) >= 0 {
+		q.Add(q, big.NewInt(1))
+	}
+	return q.Int64()
+}
+
+// excelPrecision 小数位数对应的精度，一分钟约为 0.0007 天，一秒约为 0.00001 天
+func excelPrecision(digits int) Precision {
+	switch {
+	case digits <= 2:
+		return PrecisionHour
+	case digits <= 4:
+		return PrecisionMinute
+	case digits <= 7:
+		return PrecisionSecond
+	default:
+		return PrecisionMillisecond
+	}
+}
+
+// ToExcelSerial 将时间按其所在时区的墙上时间转换为电子表格序列号
+func ToExcelSerial(t time.Time, system ExcelSystem) (float64, error) {
+	y, m, d := t.Date()
+	date := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+
+	var epoch time.Time
+	switch system {
+	case ExcelSystem1900:
+		epoch = excelEpoch1900
+		if date.Before(excelMarch1900) {
+			epoch = excelEpoch1900Early
+		}
+	case ExcelSystem1904:
+		epoch = excelEpoch1904
+	default:
+		return 0, fmt.Errorf("%w: excel date system %d", ErrUnknownUnit, system)
+	}
+	if date.Before(epoch) {
+		return 0, fmt.Errorf("%w: time %s is before the excel %s date system", ErrOutOfRange, t.Format(time.DateOnly), system)
+	}
+
+	days := date.Sub(epoch) / (24 * time.Hour)
+	clock := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute +
+		time.Duration(t.Second())*time.Second + time.Duration(t.Nanosecond())
+	return float64(days) + float64(clock)/float64(24*time.Hour), nil
+}
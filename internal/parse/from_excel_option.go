@@ -0,0 +1,20 @@
+package parse
+
+import "time"
+
+type FromExcelOption struct {
+	system ExcelSystem
+	loc    *time.Location
+}
+
+func WithFromExcelSystem(system ExcelSystem) func(*FromExcelOption) {
+	return func(o *FromExcelOption) {
+		o.system = system
+	}
+}
+
+func WithFromExcelLocation(loc *time.Location) func(*FromExcelOption) {
+	return func(o *FromExcelOption) {
+		o.loc = loc
+	}
+}
@@ -0,0 +1,88 @@
+package parse_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gomooth/chronos/internal/parse"
+)
+
+func TestFromExcelSerialString(t *testing.T) {
+	system1900 := []func(*parse.FromExcelOption){parse.WithFromExcelSystem(parse.ExcelSystem1900), parse.WithFromExcelLocation(time.UTC)}
+	system1904 := []func(*parse.FromExcelOption){parse.WithFromExcelSystem(parse.ExcelSystem1904), parse.WithFromExcelLocation(time.UTC)}
+
+	tests := []struct {
+		name      string
+		serial    string
+		opts      []func(*parse.FromExcelOption)
+		expected  time.Time
+		precision parse.Precision
+		wantErr   bool
+	}{
+		{"date", "45038", system1900, time.Date(2023, 4, 22, 0, 0, 0, 0, time.UTC), parse.PrecisionDay, false},
+		{"date time", "45038.765", system1900, time.Date(2023, 4, 22, 18, 21, 36, 0, time.UTC), parse.PrecisionMinute, false},
+		{"noon", "45038.5", system1900, time.Date(2023, 4, 22, 12, 0, 0, 0, time.UTC), parse.PrecisionHour, false},
+		{"rounded to millisecond", "45038.76545138889", system1900, time.Date(2023, 4, 22, 18, 22, 15, 0, time.UTC), parse.PrecisionMillisecond, false},
+		{"first day", "1", system1900, time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC), parse.PrecisionDay, false},
+		{"before leap bug", "59", system1900, time.Date(1900, 2, 28, 0, 0, 0, 0, time.UTC), parse.PrecisionDay, false},
+		{"after leap bug", "61", system1900, time.Date(1900, 3, 1, 0, 0, 0, 0, time.UTC), parse.PrecisionDay, false},
+		{"time only", "0.75", system1900, time.Date(1899, 12, 31, 18, 0, 0, 0, time.UTC), parse.PrecisionHour, false},
+		{"last day", "2958465", system1900, time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC), parse.PrecisionDay, false},
+		{"1904 date", "43576", system1904, time.Date(2023, 4, 22, 0, 0, 0, 0, time.UTC), parse.PrecisionDay, false},
+		{"1904 epoch", "0", system1904, time.Date(1904, 1, 1, 0, 0, 0, 0, time.UTC), parse.PrecisionDay, false},
+
+		{"leap bug day", "60", system1900, time.Time{}, 0, true},
+		{"negative", "-1", system1900, time.Time{}, 0, true},
+		{"too large", "2958466", system1900, time.Time{}, 0, true},
+		{"not numeric", "45038a", system1900, time.Time{}, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parse.FromExcelSerialString(tt.serial, tt.opts...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FromExcelSerialString(%q) error = %v, wantErr %v", tt.serial, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !got.Equal(tt.expected) {
+				t.Errorf("FromExcelSerialString(%q) = %v, want %v", tt.serial, got.Time, tt.expected)
+			}
+			if got.Precision != tt.precision {
+				t.Errorf("FromExcelSerialString(%q) precision = %v, want %v", tt.serial, got.Precision, tt.precision)
+			}
+		})
+	}
+}
+
+func TestToExcelSerial(t *testing.T) {
+	tests := []struct {
+		name     string
+		at       time.Time
+		system   parse.ExcelSystem
+		expected float64
+		wantErr  bool
+	}{
+		{"date", time.Date(2023, 4, 22, 0, 0, 0, 0, time.UTC), parse.ExcelSystem1900, 45038, false},
+		{"date time", time.Date(2023, 4, 22, 18, 0, 0, 0, time.UTC), parse.ExcelSystem1900, 45038.75, false},
+		{"wall clock", time.Date(2023, 4, 22, 12, 0, 0, 0, time.FixedZone("", 8*3600)), parse.ExcelSystem1900, 45038.5, false},
+		{"before leap bug", time.Date(1900, 2, 28, 0, 0, 0, 0, time.UTC), parse.ExcelSystem1900, 59, false},
+		{"after leap bug", time.Date(1900, 3, 1, 0, 0, 0, 0, time.UTC), parse.ExcelSystem1900, 61, false},
+		{"1904", time.Date(2023, 4, 22, 0, 0, 0, 0, time.UTC), parse.ExcelSystem1904, 43576, false},
+		{"before 1900", time.Date(1899, 1, 1, 0, 0, 0, 0, time.UTC), parse.ExcelSystem1900, 0, true},
+		{"before 1904", time.Date(1903, 12, 31, 0, 0, 0, 0, time.UTC), parse.ExcelSystem1904, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parse.ToExcelSerial(tt.at, tt.system)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ToExcelSerial(%v) error = %v, wantErr %v", tt.at, err, tt.wantErr)
+			}
+			if got != tt.expected {
+				t.Errorf("ToExcelSerial(%v) = %v, want %v", tt.at, got, tt.expected)
+			}
+		})
+	}
+}
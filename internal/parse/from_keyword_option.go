@@ -0,0 +1,22 @@
+package parse
+
+import "time"
+
+type FromKeywordOption struct {
+	baseTime *time.Time
+	loc      *time.Location
+}
+
+func WithFromKeywordBaseTime(base time.Time) func(*FromKeywordOption) {
+	return func(o *FromKeywordOption) {
+		if !base.IsZero() {
+			o.baseTime = &base
+		}
+	}
+}
+
+func WithFromKeywordLocation(loc *time.Location) func(*FromKeywordOption) {
+	return func(o *FromKeywordOption) {
+		o.loc = loc
+	}
+}
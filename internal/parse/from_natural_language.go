@@ -1,16 +1,17 @@
 package parse
 
 import (
-	"fmt"
 	"regexp"
 	"strconv"
 	"strings"
 	"time"
 )
 
+var naturalLanguageRe = regexp.MustCompile(`(?i)^\s*(a|an|\d+)\s+([a-z]+?)s?\s+(ago|later|before|after)\s*$`)
+
 // FromNaturalLanguage 解析自然语言时间表达式
 // 支持格式如: "an hour ago", "2 days later", "5 minutes ago" 等
-func FromNaturalLanguage(expr string, opts ...func(*FromNaturalLanguageOption)) (time.Time, error) {
+func FromNaturalLanguage(expr string, opts ...func(*FromNaturalLanguageOption)) (Result, error) {
 	cnf := new(FromNaturalLanguageOption)
 	for _, opt := range opts {
 		opt(cnf)
@@ -25,63 +26,56 @@ func FromNaturalLanguage(expr string, opts ...func(*FromNaturalLanguageOption))
 		loc = cnf.loc
 	}
 
-	// 处理常见特殊表达式
-	switch strings.ToLower(expr) {
-	case "now":
-		return baseTime.In(loc), nil
-	case "today":
-		return time.Date(baseTime.Year(), baseTime.Month(), baseTime.Day(), 0, 0, 0, 0, loc), nil
-	case "yesterday":
-		return time.Date(baseTime.Year(), baseTime.Month(), baseTime.Day()-1, 0, 0, 0, 0, loc), nil
-	case "tomorrow":
-		return time.Date(baseTime.Year(), baseTime.Month(), baseTime.Day()+1, 0, 0, 0, 0, loc), nil
+	// 关键字，与 FromKeyword 使用同一份注册表
+	if keyword, ok := lookupKeyword(expr); ok {
+		return keywordResult(keyword.Resolve(baseTime.In(loc)), keyword.Precision), nil
 	}
 
 	// 正则表达式匹配模式
-	re := regexp.MustCompile(`(?i)^\s*(a|an|\d+)\s+(nanosecond|microsecond|millisecond|second|minute|hour|day|week|month|year)s?\s+(ago|later|before|after)\s*$`)
-	matches := re.FindStringSubmatch(expr)
+	matches := naturalLanguageRe.FindStringSubmatch(expr)
 	if matches == nil {
-		return time.Time{}, fmt.Errorf("unsurpported time expression: %s", expr)
+		return Result{}, newError(KindNaturalLanguage, "", ErrUnsupportedExpression, "%s", expr)
 	}
 
 	// 解析数量
 	var quantity int
-	if matches[1] == "a" || matches[1] == "an" {
+	if q := strings.ToLower(matches[1]); q == "a" || q == "an" {
 		quantity = 1
 	} else {
 		var err error
 		quantity, err = strconv.Atoi(matches[1])
 		if err != nil {
-			return time.Time{}, fmt.Errorf("invalid quantity: %s", matches[1])
+			return Result{}, newError(KindNaturalLanguage, "quantity", ErrOutOfRange, "invalid quantity: %s", matches[1])
 		}
 	}
 
 	// 解析时间单位
 	var duration time.Duration
 	var days, months, years int
+	var precision Precision
 	switch strings.ToLower(matches[2]) {
 	case "nanosecond":
-		duration = time.Duration(quantity) * time.Nanosecond
+		duration, precision = time.Duration(quantity)*time.Nanosecond, PrecisionNanosecond
 	case "microsecond":
-		duration = time.Duration(quantity) * time.Microsecond
+		duration, precision = time.Duration(quantity)*time.Microsecond, PrecisionMicrosecond
 	case "millisecond":
-		duration = time.Duration(quantity) * time.Millisecond
+		duration, precision = time.Duration(quantity)*time.Millisecond, PrecisionMillisecond
 	case "second":
-		duration = time.Duration(quantity) * time.Second
+		duration, precision = time.Duration(quantity)*time.Second, PrecisionSecond
 	case "minute":
-		duration = time.Duration(quantity) * time.Minute
+		duration, precision = time.Duration(quantity)*time.Minute, PrecisionMinute
 	case "hour":
-		duration = time.Duration(quantity) * time.Hour
+		duration, precision = time.Duration(quantity)*time.Hour, PrecisionHour
 	case "day":
-		days = quantity
+		days, precision = quantity, PrecisionDay
 	case "week":
-		days = quantity * 7
+		days, precision = quantity*7, PrecisionDay
 	case "month":
-		months = quantity
+		months, precision = quantity, PrecisionMonth
 	case "year":
-		years = quantity
+		years, precision = quantity, PrecisionYear
 	default:
-		return time.Time{}, fmt.Errorf("unknown time unit: %s", matches[2])
+		return Result{}, newError(KindNaturalLanguage, "unit", ErrUnknownUnit, "%s", matches[2])
 	}
 
 	// 计算时间
@@ -94,15 +88,15 @@ func FromNaturalLanguage(expr string, opts ...func(*FromNaturalLanguageOption))
 	case "later", "after":
 		// pass
 	default:
-		return time.Time{}, fmt.Errorf("unknown direction: %s", matches[3])
+		return Result{}, newError(KindNaturalLanguage, "direction", ErrUnsupportedExpression, "unknown direction: %s", matches[3])
 	}
 
+	at := baseTime.In(loc)
 	if duration != 0 {
-		return baseTime.Add(duration).In(loc), nil
-	}
-	if days != 0 || months != 0 || years != 0 {
-		return baseTime.AddDate(years, months, days).In(loc), nil
+		at = baseTime.Add(duration).In(loc)
+	} else if days != 0 || months != 0 || years != 0 {
+		at = baseTime.AddDate(years, months, days).In(loc)
 	}
 
-	return baseTime.In(loc), nil
+	return Result{Time: at, Kind: KindNaturalLanguage, Precision: precision}, nil
 }
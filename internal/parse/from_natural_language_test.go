@@ -158,3 +158,21 @@ func TestCaseInsensitivity(t *testing.T) {
 		})
 	}
 }
+
+// 测试冠词数量不区分大小写
+func TestArticleQuantity(t *testing.T) {
+	baseTime := time.Date(2023, 5, 15, 12, 0, 0, 0, time.UTC)
+	expected := time.Date(2023, 5, 15, 13, 0, 0, 0, time.UTC)
+
+	for _, expr := range []string{"a hour later", "A hour later", "an hour later", "AN hour later", "An hour later"} {
+		t.Run(expr, func(t *testing.T) {
+			got, err := parse.FromNaturalLanguage(expr, parse.WithFromNaturalLanguageBaseTime(baseTime))
+			if err != nil {
+				t.Fatalf("FromNaturalLanguage() failed: %v", err)
+			}
+			if !got.Equal(expected) {
+				t.Errorf("FromNaturalLanguage() = %v, want %v", got, expected)
+			}
+		})
+	}
+}
@@ -1,7 +1,8 @@
 package parse
 
 import (
-	"fmt"
+	"errors"
+	"strings"
 	"time"
 
 	"github.com/gomooth/chronos/timelayout"
@@ -21,28 +22,290 @@ var stringFormats = []string{
 	"2006/01/02",
 }
 
+var (
+	compiledStringFormats = compileLayouts(stringFormats)
+	compiledDateOrders    = map[DateOrder][]compiledLayout{
+		DateOrderDMY: compileLayouts(dateOrderLayouts[DateOrderDMY]),
+		DateOrderMDY: compileLayouts(dateOrderLayouts[DateOrderMDY]),
+		DateOrderYMD: compileLayouts(dateOrderLayouts[DateOrderYMD]),
+	}
+
+	// 未指定自定义格式时的索引，所有 StringFormat 共用
+	stringFormatIndex = newLayoutIndex(compiledStringFormats)
+	dateOrderIndexes  = map[DateOrder]*layoutIndex{
+		DateOrderDMY: newLayoutIndex(dateOrderFormats(DateOrderDMY)),
+		DateOrderMDY: newLayoutIndex(dateOrderFormats(DateOrderMDY)),
+		DateOrderYMD: newLayoutIndex(dateOrderFormats(DateOrderYMD)),
+	}
+)
+
+// dateOrderFormats 日期顺序格式在前、内置格式在后的格式列表
+func dateOrderFormats(order DateOrder) []compiledLayout {
+	return append(append([]compiledLayout{}, compiledDateOrders[order]...), compiledStringFormats...)
+}
+
 // FromStringFormat 尝试解析各种格式的时间字符串
-func FromStringFormat(s string, opts ...func(*FromStringOption)) (time.Time, error) {
+func FromStringFormat(s string, opts ...func(*FromStringOption)) (Result, error) {
+	return NewStringFormat(opts...).Parse(s)
+}
+
+// StringFormat 整理好的格式列表，创建后只读，可复用且并发安全
+type StringFormat struct {
+	layouts   []compiledLayout
+	index     *layoutIndex // 按输入形态索引的 layouts
+	baseTime  *time.Time   // 推断年份、锚定日期的基准时间，为空时取当前时间
+	anchor    *time.Time   // 仅含时间的结果所在的日期，零值表示基准时间的日期
+	next      bool         // 仅含时间的结果早于基准时间时顺延到下一天
+	twoDigit  TwoDigitYear
+	normalize bool       // 规范化 24:00:00 及闰秒
+	leap      LeapSecond // 闰秒的处理策略
+	loc       *time.Location
+	dateOrder DateOrder
+	strict    bool
+	zones     map[string]*time.Location // 时区缩写偏好
+	conflict  ZoneConflict
+
+	lenient      bool
+	lenientIndex *layoutIndex // 宽松模式下整理后的格式及手写日期格式
+	noDigitISO   bool         // 纯数字输入不按 ISO 8601 基本格式解析
+}
+
+type compiledLayout struct {
+	layout string
+	shape  shape
+	abbr   bool // 格式中含有时区缩写
+
+	yearless bool // 格式中有月、日但没有年份
+	timeOnly bool // 格式中只有时间，没有日期
+	twoDigit bool // 格式中含有两位年份
+
+	precision Precision // 格式的精度，精确到秒时按输入的小数位数细化
+}
+
+// NewStringFormat 按选项整理格式列表：自定义格式、日期顺序格式、内置格式
+func NewStringFormat(opts ...func(*FromStringOption)) *StringFormat {
 	cnf := new(FromStringOption)
 	for _, opt := range opts {
 		opt(cnf)
 	}
 
-	formats := stringFormats
+	f := &StringFormat{
+		layouts:    compiledStringFormats,
+		index:      stringFormatIndex,
+		baseTime:   cnf.baseTime,
+		anchor:     cnf.anchor,
+		next:       cnf.next,
+		twoDigit:   cnf.twoDigitYear,
+		normalize:  cnf.normalize,
+		leap:       cnf.leapSecond,
+		lenient:    cnf.lenient,
+		noDigitISO: cnf.noDigitISO,
+		loc:        time.Local,
+		dateOrder:  cnf.dateOrder,
+		strict:     cnf.strict,
+		zones:      cnf.zones,
+		conflict:   cnf.zoneConflict,
+	}
+	if cnf.loc != nil {
+		f.loc = cnf.loc
+	}
+	if len(compiledDateOrders[cnf.dateOrder]) > 0 {
+		f.layouts = dateOrderFormats(cnf.dateOrder)
+		f.index = dateOrderIndexes[cnf.dateOrder]
+	}
 	if len(cnf.layouts) > 0 {
-		formats = append(cnf.layouts, formats...)
+		f.layouts = append(compileLayouts(cnf.layouts), f.layouts...)
+		f.index = newLayoutIndex(f.layouts)
 	}
+	if f.lenient {
+		layouts := make([]string, 0, len(f.layouts))
+		for _, l := range f.layouts {
+			layouts = append(layouts, lenientLayout(l.layout))
+		}
+		f.lenientIndex = newLayoutIndex(append(compileLayouts(layouts), lenientLayouts...))
+	}
+	return f
+}
 
-	loc := time.Local
-	if cnf.loc != nil {
-		loc = cnf.loc
+// Parse 按顺序尝试各格式，按输入形态从索引中取出候选格式，跳过必然无法匹配的格式
+// 所有格式均不匹配时，再按 ISO 8601 语法解析
+// 输入末尾带有 IANA 时区名或 RFC 9557 方括号时区时，按该时区解析其余部分
+// 开启规范化时，24:00:00 转换为次日零点，闰秒 23:59:60 按策略转换
+// 开启宽松模式时，原输入无法解析则整理空白、大小写、序数词、逗号及缩写后的句点后再次尝试
+func (f *StringFormat) Parse(s string) (Result, error) {
+	res, err := f.parseNormalized(s)
+	if err != nil && f.lenient {
+		if lenient, ok := f.parseLenient(s); ok {
+			return lenient, nil
+		}
+	}
+	return res, err
+}
+
+func (f *StringFormat) parseNormalized(s string) (Result, error) {
+	if !f.normalize {
+		return f.parseZoned(s)
+	}
+
+	normalized, n := normalizeClock(s, f.leap)
+	if n == NormalizationNone {
+		return f.parseZoned(s)
+	}
+	res, err := f.parseZoned(normalized)
+	if err != nil {
+		// 按原始输入返回错误，错误信息中不出现改写后的输入
+		return f.parseZoned(s)
+	}
+	return f.applyNormalization(res, n), nil
+}
+
+func (f *StringFormat) parseZoned(s string) (Result, error) {
+	rest, name := splitZoneSuffix(s)
+	if name != "" {
+		return f.parseInZone(rest, name)
+	}
+	res, err := f.parse(rest)
+	if err != nil {
+		if zoned, ok, zoneErr := f.parseZoneWordSuffix(s); ok {
+			return zoned, zoneErr
+		}
+	}
+	return res, err
+}
+
+func (f *StringFormat) parse(s string) (Result, error) {
+	in := inputShape(s)
+	var failed layoutFailure
+	for _, l := range f.index.lookup(in) {
+		t, err := time.ParseInLocation(l.layout, s, f.loc)
+		if err != nil {
+			failed.setRange(l.layout, s, err)
+			continue
+		}
+		if l.twoDigit && !f.twoDigit.IsZero() {
+			var rangeErr *ParseError
+			if t, rangeErr = f.resolveTwoDigitYear(t, s, l.layout); rangeErr != nil {
+				failed.set(rangeErr)
+				continue
+			}
+		}
+		if f.strict && f.dateOrder != DateOrderYMD && isDateOrderLayout(f.dateOrder, l.layout) &&
+			ambiguousDayMonth(t.Day(), int(t.Month())) {
+			return Result{}, newError(KindLayout, "date", ErrAmbiguous, "%s can be read as both DMY and MDY", s)
+		}
+		if l.yearless {
+			t = f.inferYear(t)
+		}
+		if l.timeOnly && (f.anchor != nil || f.next) {
+			t = f.anchorDate(t)
+		}
+		if l.abbr {
+			if t, err = f.resolveZoneAbbr(t); err != nil {
+				return Result{}, err
+			}
+		}
+		return Result{
+			Time:      t,
+			Layout:    l.layout,
+			Kind:      KindLayout,
+			Precision: l.resultPrecision(s),
+		}, nil
 	}
 
-	for _, format := range formats {
-		if t, err := time.ParseInLocation(format, s, loc); err == nil {
-			return t, nil
+	// 尝试按 ISO 8601 语法解析，纯数字输入按选项跳过
+	if !f.noDigitISO || !isDigits(s) {
+		res, err := ParseISO8601(s, f.loc)
+		if err == nil {
+			return res, nil
 		}
+		if errors.Is(err, ErrOutOfRange) {
+			failed.set(err.(*ParseError))
+		}
+	}
+	pe := failed.error()
+	if pe == nil {
+		pe = newError(KindLayout, "", ErrUnsupportedExpression, "could not parse time string: %s", s)
+	}
+	pe.Layouts = f.candidates(in)
+	return Result{}, pe
+}
+
+// layoutFailure 第一个结构与输入一致但字段超出范围的格式
+// time.Parse 返回的错误在确定解析失败后才转换，后续格式解析成功时不生成错误
+type layoutFailure struct {
+	err    *ParseError
+	layout string
+	parse  *time.ParseError
+}
+
+func (f *layoutFailure) set(err *ParseError) {
+	if f.err == nil && f.parse == nil {
+		f.err = err
+	}
+}
+
+// setRange 记录字段超出范围的 time.Parse 错误，其他错误忽略
+// time.Parse 在读到超出范围的字段时立即返回，不检查其余部分，因此仅在格式与输入结构一致时记录
+func (f *layoutFailure) setRange(layout, s string, err error) {
+	if f.err != nil || f.parse != nil {
+		return
+	}
+	if pe, ok := err.(*time.ParseError); ok && strings.HasSuffix(pe.Message, " out of range") && layoutMatches(layout, s) {
+		f.layout, f.parse = layout, pe
+	}
+}
+
+func (f *layoutFailure) error() *ParseError {
+	if f.parse != nil {
+		return layoutRangeError(f.layout, f.parse)
+	}
+	return f.err
+}
+
+// resultPrecision 匹配结果的精度，精确到秒时以输入的小数位数为准
+func (l compiledLayout) resultPrecision(s string) Precision {
+	if l.precision == PrecisionSecond {
+		return fractionPrecision(secondFractionDigits(s))
+	}
+	return l.precision
+}
+
+// candidates 与输入形态相符、会被尝试的格式
+func (f *StringFormat) candidates(in shape) []string {
+	candidates := f.index.lookup(in)
+	layouts := make([]string, 0, len(candidates))
+	for _, l := range candidates {
+		layouts = append(layouts, l.layout)
 	}
+	return layouts
+}
 
-	return time.Time{}, fmt.Errorf("could not parse time string: %s", s)
+// layoutRangeError 将 time.Parse 返回的字段超出范围错误（如 ": day out of range"）转换为 ErrOutOfRange，其他错误返回 nil
+func layoutRangeError(layout string, err error) *ParseError {
+	var pe *time.ParseError
+	if !errors.As(err, &pe) {
+		return nil
+	}
+	component, ok := strings.CutSuffix(strings.TrimPrefix(pe.Message, ": "), " out of range")
+	if !ok {
+		return nil
+	}
+	return newError(KindLayout, component, ErrOutOfRange, "%s as %q", pe.Value, layout)
+}
+
+func compileLayouts(layouts []string) []compiledLayout {
+	compiled := make([]compiledLayout, 0, len(layouts))
+	for _, layout := range layouts {
+		compiled = append(compiled, compiledLayout{
+			layout:   layout,
+			shape:    layoutShape(layout),
+			abbr:     strings.Contains(layout, "MST"),
+			yearless: isYearless(layout),
+			timeOnly: isTimeOnly(layout),
+			twoDigit: hasTwoDigitYear(layout),
+
+			precision: layoutPrecision(layout, ""),
+		})
+	}
+	return compiled
 }
@@ -1,10 +1,26 @@
 package parse
 
-import "time"
+import (
+	"strings"
+	"time"
+)
 
 type FromStringOption struct {
-	layouts []string
-	loc     *time.Location
+	layouts   []string
+	baseTime  *time.Time
+	anchor    *time.Time
+	next      bool
+	loc       *time.Location
+	dateOrder DateOrder
+	strict    bool
+	zones     map[string]*time.Location
+
+	zoneConflict ZoneConflict
+	twoDigitYear TwoDigitYear
+	normalize    bool
+	leapSecond   LeapSecond
+	lenient      bool
+	noDigitISO   bool
 }
 
 func WithFromStringLayout(layout string, others ...string) func(*FromStringOption) {
@@ -17,8 +33,88 @@ func WithFromStringLayout(layout string, others ...string) func(*FromStringOptio
 	}
 }
 
+// WithFromStringBaseTime 指定基准时间，用于推断不含年份的格式（如 time.Stamp）的年份
+func WithFromStringBaseTime(base time.Time) func(*FromStringOption) {
+	return func(o *FromStringOption) {
+		if !base.IsZero() {
+			o.baseTime = &base
+		}
+	}
+}
+
+// WithFromStringAnchorDate 将仅含时间的结果（如 time.Kitchen、time.TimeOnly）置于指定日期，日期为零值时取基准时间的日期
+func WithFromStringAnchorDate(date time.Time) func(*FromStringOption) {
+	return func(o *FromStringOption) {
+		o.anchor = &date
+	}
+}
+
+// WithFromStringNextOccurrence 仅含时间的结果早于基准时间时顺延到下一天，未指定锚定日期时取基准时间的日期
+func WithFromStringNextOccurrence(next bool) func(*FromStringOption) {
+	return func(o *FromStringOption) {
+		o.next = next
+	}
+}
+
 func WithFromStringLocation(loc *time.Location) func(*FromStringOption) {
 	return func(o *FromStringOption) {
 		o.loc = loc
 	}
 }
+
+func WithFromStringDateOrder(order DateOrder) func(*FromStringOption) {
+	return func(o *FromStringOption) {
+		o.dateOrder = order
+	}
+}
+
+func WithFromStringStrict(strict bool) func(*FromStringOption) {
+	return func(o *FromStringOption) {
+		o.strict = strict
+	}
+}
+
+func WithFromStringZonePreference(abbr string, loc *time.Location) func(*FromStringOption) {
+	return func(o *FromStringOption) {
+		if o.zones == nil {
+			o.zones = make(map[string]*time.Location)
+		}
+		o.zones[strings.ToUpper(abbr)] = loc
+	}
+}
+
+func WithFromStringZoneConflict(conflict ZoneConflict) func(*FromStringOption) {
+	return func(o *FromStringOption) {
+		o.zoneConflict = conflict
+	}
+}
+
+// WithFromStringTwoDigitYear 指定两位年份的转换规则，用于含有两位年份 "06" 的格式
+func WithFromStringTwoDigitYear(rule TwoDigitYear) func(*FromStringOption) {
+	return func(o *FromStringOption) {
+		o.twoDigitYear = rule
+	}
+}
+
+// WithFromStringNormalize 将 24:00:00 规范化为次日零点，闰秒 23:59:60 按 leap 指定的策略处理
+func WithFromStringNormalize(leap LeapSecond) func(*FromStringOption) {
+	return func(o *FromStringOption) {
+		o.normalize = true
+		o.leapSecond = leap
+	}
+}
+
+// WithFromStringLenient 宽松模式：原输入无法解析时，整理空白、大小写、序数词后缀、逗号及缩写后的句点后再次尝试
+func WithFromStringLenient(lenient bool) func(*FromStringOption) {
+	return func(o *FromStringOption) {
+		o.lenient = lenient
+	}
+}
+
+// WithFromStringDigitOnlyISO 指定纯数字输入（如 "2023"、"20230422"、"2023112"）是否按 ISO 8601 基本格式解析，默认支持
+// 调用方另行将纯数字解析为时间戳时应关闭，以免同为纯数字的输入部分按日期、部分按时间戳解析
+func WithFromStringDigitOnlyISO(supported bool) func(*FromStringOption) {
+	return func(o *FromStringOption) {
+		o.noDigitISO = !supported
+	}
+}
@@ -0,0 +1,257 @@
+package parse
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// 形态过滤只能跳过必然失败的格式，结果必须与逐个尝试所有格式一致
+// 按形态索引取出的候选格式须与逐个判断形态的结果相同，且保持原有顺序
+func TestStringFormatShapeFilter(t *testing.T) {
+	inputs := []string{
+		"Mon Jan  2 15:04:05 2006", "Mon Jan  2 15:04:05 MST 2006", "Mon Jan 02 15:04:05 -0700 2006",
+		"02 Jan 06 15:04 MST", "02 Jan 06 15:04 -0700", "Monday, 02-Jan-06 15:04:05 MST",
+		"Mon, 02 Jan 2006 15:04:05 MST", "Mon, 02 Jan 2006 15:04:05 -0700",
+		"2023-04-22T18:22:15Z", "2023-04-22T18:22:15.123456789+08:00", "2023-04-22T18:22:15-07:00",
+		"3:04PM", "Jan  2 15:04:05", "Jan  2 15:04:05.000", "Jan  2 15:04:05.000000",
+		"2023-04-22 18:22:15", "2023-04-22", "18:22:15", "2023/04/22",
+		"Monday, 02-Jan-2006 15:04:05 MST", "Mon, 02 Jan 06 15:04:05 -0700",
+		"22/04/2023", "4/22/2023 18:22", "2023.4.22", "22.04.23", "20230422", "", "-",
+		"+08:00", "invalid", "12345", "2023-04", "18:22", "2023_04_22", "22h04",
+	}
+	layouts := append(append(append([]string{"02/01/2006", "20060102", "Z07:00", "MST 2006"},
+		dateOrderLayouts[DateOrderDMY]...), dateOrderLayouts[DateOrderYMD]...), stringFormats...)
+	compiled := compileLayouts(layouts)
+	index := newLayoutIndex(compiled)
+
+	for _, s := range inputs {
+		var want string
+		for _, layout := range layouts {
+			if _, err := time.Parse(layout, s); err == nil {
+				want = layout
+				break
+			}
+		}
+
+		in := inputShape(s)
+		var accepted []string
+		for _, l := range compiled {
+			if l.shape.accepts(in) {
+				accepted = append(accepted, l.layout)
+			}
+		}
+		var indexed []string
+		for _, l := range index.lookup(in) {
+			indexed = append(indexed, l.layout)
+		}
+		if strings.Join(indexed, "|") != strings.Join(accepted, "|") {
+			t.Errorf("input %q indexed layouts %q, want %q", s, indexed, accepted)
+		}
+
+		var got string
+		for _, layout := range indexed {
+			if _, err := time.Parse(layout, s); err == nil {
+				got = layout
+				break
+			}
+		}
+		if got != want {
+			t.Errorf("input %q matched layout %q with shape filter, want %q", s, got, want)
+		}
+	}
+}
+
+func TestStringFormatZoneAbbr(t *testing.T) {
+	shanghai := time.FixedZone("CST", 8*3600)
+	chicago, err := time.LoadLocation("America/Chicago")
+	if err != nil {
+		t.Skip("time zone database not available")
+	}
+
+	tests := []struct {
+		name    string
+		input   string
+		opts    []func(*FromStringOption)
+		offset  int
+		wantErr bool
+	}{
+		{"pst", "Sat, 22 Apr 2023 18:22:15 PST", nil, -8 * 3600, false},
+		{"pdt", "Sat, 22 Apr 2023 18:22:15 PDT", nil, -7 * 3600, false},
+		{"unix date jst", "Sat Apr 22 18:22:15 JST 2023", nil, 9 * 3600, false},
+		{"half hour", "Sat, 22 Apr 2023 18:22:15 IST", nil, 5*3600 + 1800, false},
+		{"gmt", "Sat, 22 Apr 2023 18:22:15 GMT", nil, 0, false},
+		{"utc", "Sat, 22 Apr 2023 18:22:15 UTC", nil, 0, false},
+		{"cst default", "Sat, 22 Apr 2023 18:22:15 CST", nil, -6 * 3600, false},
+		{"cst preference", "Sat, 22 Apr 2023 18:22:15 CST", []func(*FromStringOption){WithFromStringZonePreference("CST", shanghai)}, 8 * 3600, false},
+		{"preference for unknown", "Sat, 22 Apr 2023 18:22:15 XYZ", []func(*FromStringOption){WithFromStringZonePreference("xyz", shanghai)}, 8 * 3600, false},
+		{"location abbreviation", "Sat, 22 Apr 2023 18:22:15 CDT", []func(*FromStringOption){WithFromStringLocation(chicago)}, -5 * 3600, false},
+		{"cst ambiguous in strict mode", "Sat, 22 Apr 2023 18:22:15 CST", []func(*FromStringOption){WithFromStringStrict(true)}, 0, true},
+		{"unknown", "Sat, 22 Apr 2023 18:22:15 XYZ", nil, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := append([]func(*FromStringOption){WithFromStringLocation(time.UTC)}, tt.opts...)
+			got, err := FromStringFormat(tt.input, opts...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FromStringFormat(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if _, offset := got.Zone(); offset != tt.offset {
+				t.Errorf("FromStringFormat(%q) offset = %d, want %d", tt.input, offset, tt.offset)
+			}
+			if got.Hour() != 18 || got.Minute() != 22 {
+				t.Errorf("FromStringFormat(%q) = %v, wall clock changed", tt.input, got.Time)
+			}
+		})
+	}
+}
+
+func TestStringFormatYearInference(t *testing.T) {
+	newYear := time.Date(2024, 1, 5, 10, 0, 0, 0, time.UTC)
+	summer := time.Date(2024, 6, 15, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		input  string
+		base   time.Time
+		layout []string
+		want   time.Time
+	}{
+		{"december rollover", "Dec 31 23:59:59", newYear, nil, time.Date(2023, 12, 31, 23, 59, 59, 0, time.UTC)},
+		{"same year", "Jan  2 15:04:05", newYear, nil, time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)},
+		{"slightly ahead", "Jan  8 15:04:05", newYear, nil, time.Date(2024, 1, 8, 15, 4, 5, 0, time.UTC)},
+		{"too far ahead", "Jan 20 15:04:05", newYear, nil, time.Date(2023, 1, 20, 15, 4, 5, 0, time.UTC)},
+		{"half a year before", "Dec 10 08:00:00.123", summer, nil, time.Date(2023, 12, 10, 8, 0, 0, 123000000, time.UTC)},
+		{"leap day", "Feb 29 12:00:00", time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), nil, time.Date(2024, 2, 29, 12, 0, 0, 0, time.UTC)},
+		{"custom layout", "12/31 23:00", newYear, []string{"01/02 15:04"}, time.Date(2023, 12, 31, 23, 0, 0, 0, time.UTC)},
+		{"time only unchanged", "18:22:15", newYear, nil, time.Date(0, 1, 1, 18, 22, 15, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := []func(*FromStringOption){WithFromStringLocation(time.UTC), WithFromStringBaseTime(tt.base)}
+			if len(tt.layout) > 0 {
+				opts = append(opts, WithFromStringLayout(tt.layout[0], tt.layout[1:]...))
+			}
+			got, err := FromStringFormat(tt.input, opts...)
+			if err != nil {
+				t.Fatalf("FromStringFormat(%q) error = %v", tt.input, err)
+			}
+			if !got.Time.Equal(tt.want) {
+				t.Errorf("FromStringFormat(%q) = %v, want %v", tt.input, got.Time, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsYearless(t *testing.T) {
+	tests := map[string]bool{
+		time.Stamp: true, time.StampNano: true, "01/02 15:04": true, "Jan 2": true,
+		time.DateTime: false, time.RFC1123: false, "02.01.06": false, time.TimeOnly: false, time.Kitchen: false, "Mon 15:04": false,
+	}
+	for layout, want := range tests {
+		if got := isYearless(layout); got != want {
+			t.Errorf("isYearless(%q) = %v, want %v", layout, got, want)
+		}
+	}
+}
+
+func TestStringFormatAnchorDate(t *testing.T) {
+	base := time.Date(2024, 3, 10, 18, 0, 0, 0, time.UTC)
+	anchor := time.Date(2023, 4, 22, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		input string
+		opts  []func(*FromStringOption)
+		want  time.Time
+	}{
+		{"no anchor", "15:04:05", nil, time.Date(0, 1, 1, 15, 4, 5, 0, time.UTC)},
+		{"anchor date", "15:04:05", []func(*FromStringOption){WithFromStringAnchorDate(anchor)}, time.Date(2023, 4, 22, 15, 4, 5, 0, time.UTC)},
+		{"kitchen", "3:04PM", []func(*FromStringOption){WithFromStringAnchorDate(anchor)}, time.Date(2023, 4, 22, 15, 4, 0, 0, time.UTC)},
+		{"base date", "09:30:00", []func(*FromStringOption){WithFromStringAnchorDate(time.Time{})}, time.Date(2024, 3, 10, 9, 30, 0, 0, time.UTC)},
+		{"next occurrence passed", "09:30:00", []func(*FromStringOption){WithFromStringNextOccurrence(true)}, time.Date(2024, 3, 11, 9, 30, 0, 0, time.UTC)},
+		{"next occurrence upcoming", "20:00:00", []func(*FromStringOption){WithFromStringNextOccurrence(true)}, time.Date(2024, 3, 10, 20, 0, 0, 0, time.UTC)},
+		{"next occurrence now", "18:00:00", []func(*FromStringOption){WithFromStringNextOccurrence(true)}, base},
+		{"dated layout unchanged", "2023-04-22 09:30:00", []func(*FromStringOption){WithFromStringNextOccurrence(true)}, time.Date(2023, 4, 22, 9, 30, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := append([]func(*FromStringOption){WithFromStringLocation(time.UTC), WithFromStringBaseTime(base)}, tt.opts...)
+			got, err := FromStringFormat(tt.input, opts...)
+			if err != nil {
+				t.Fatalf("FromStringFormat(%q) error = %v", tt.input, err)
+			}
+			if !got.Time.Equal(tt.want) {
+				t.Errorf("FromStringFormat(%q) = %v, want %v", tt.input, got.Time, tt.want)
+			}
+		})
+	}
+
+	t.Run("location", func(t *testing.T) {
+		shanghai := time.FixedZone("UTC+8", 8*3600)
+		// 基准时间 18:00 UTC 在东八区已是 3 月 11 日
+		got, err := FromStringFormat("08:00:00", WithFromStringLocation(shanghai), WithFromStringBaseTime(base), WithFromStringAnchorDate(time.Time{}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := time.Date(2024, 3, 11, 8, 0, 0, 0, shanghai); !got.Time.Equal(want) {
+			t.Errorf("FromStringFormat(%q) = %v, want %v", "08:00:00", got.Time, want)
+		}
+	})
+}
+
+func TestLayoutMatches(t *testing.T) {
+	tests := []struct {
+		layout string
+		input  string
+		want   bool
+	}{
+		{time.DateOnly, "2023-02-30", true},
+		{time.RFC3339, "2023-04-22T25:00:00Z", true},
+		{time.RFC3339, "2023-04-22T25:00:00+08:00", true},
+		{time.RFC3339Nano, "2023-04-22T25:00:00.5Z", true},
+		{time.Stamp, "Jan  2 25:04:05", true},
+		{time.Kitchen, "16:75", false},
+		{time.Layout, "31/12/2023", false},
+		{time.RFC3339, "2023-366", false},
+		{"01/02/2006", "1672643045", false},
+	}
+
+	for _, tt := range tests {
+		if got := layoutMatches(tt.layout, tt.input); got != tt.want {
+			t.Errorf("layoutMatches(%q, %q) = %v, want %v", tt.layout, tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestLayoutPrecision(t *testing.T) {
+	tests := []struct {
+		layout string
+		input  string
+		want   Precision
+	}{
+		{"2006", "2023", PrecisionYear},
+		{"2006-01", "2023-04", PrecisionMonth},
+		{"2/1/2006", "3/4/2023", PrecisionDay},
+		{"1-2-06", "3-4-23", PrecisionDay},
+		{"January 2006", "April 2023", PrecisionMonth},
+		{"January 2 2006", "April 22 2023", PrecisionDay},
+		{"2006.1", "2023.4", PrecisionMonth},
+		{"3:04PM", "6:22PM", PrecisionMinute},
+		{time.RFC1123Z, "Sat, 22 Apr 2023 18:22:15 +0800", PrecisionSecond},
+		{time.RFC3339Nano, "2023-04-22T18:22:15.123Z", PrecisionMillisecond},
+		{"2006-01-02 15 MST", "2023-04-22 18 CST", PrecisionHour},
+	}
+
+	for _, tt := range tests {
+		if got := layoutPrecision(tt.layout, tt.input); got != tt.want {
+			t.Errorf("layoutPrecision(%q, %q) = %v, want %v", tt.layout, tt.input, got, tt.want)
+		}
+	}
+}
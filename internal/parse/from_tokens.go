@@ -0,0 +1,323 @@
+package parse
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	tokenSplitter = regexp.MustCompile(`[\s,()]+`)
+
+	tokenTimeRe   = regexp.MustCompile(`^(\d{1,2}):(\d{2})(?::(\d{2})(?:[.,](\d{1,9}))?)?(am|pm|a\.m\.|p\.m\.)?$`)
+	tokenHourRe   = regexp.MustCompile(`^(\d{1,2})h(?:(\d{2})(?:m(?:(\d{2})s?)?)?)?$`)
+	tokenClockRe  = regexp.MustCompile(`^(\d{1,2})(am|pm|a\.m\.|p\.m\.)$`)
+	tokenDateRe   = regexp.MustCompile(`^(\d{1,4})([./-])(\d{1,2})([./-])(\d{1,4})$`)
+	tokenOffsetRe = regexp.MustCompile(`^(?:utc|gmt)?([+-])(\d{1,2})(?::?(\d{2}))?$`)
+	tokenNumberRe = regexp.MustCompile(`^(\d+)(?:st|nd|rd|th)?\.?$`)
+)
+
+var tokenMonths = map[string]time.Month{
+	"jan": time.January, "january": time.January,
+	"feb": time.February, "february": time.February,
+	"mar": time.March, "march": time.March,
+	"apr": time.April, "april": time.April,
+	"may": time.May,
+	"jun": time.June, "june": time.June,
+	"jul": time.July, "july": time.July,
+	"aug": time.August, "august": time.August,
+	"sep": time.September, "sept": time.September, "september": time.September,
+	"oct": time.October, "october": time.October,
+	"nov": time.November, "november": time.November,
+	"dec": time.December, "december": time.December,
+}
+
+var tokenWeekdays = map[string]time.Weekday{
+	"sun": time.Sunday, "sunday": time.Sunday,
+	"mon": time.Monday, "monday": time.Monday,
+	"tue": time.Tuesday, "tues": time.Tuesday, "tuesday": time.Tuesday,
+	"wed": time.Wednesday, "wednesday": time.Wednesday,
+	"thu": time.Thursday, "thur": time.Thursday, "thurs": time.Thursday, "thursday": time.Thursday,
+	"fri": time.Friday, "friday": time.Friday,
+	"sat": time.Saturday, "saturday": time.Saturday,
+}
+
+// tokenFillers 无实际含义、始终跳过的词
+var tokenFillers = map[string]bool{
+	"at": true, "on": true, "of": true, "the": true, "and": true, "in": true, "t": true,
+}
+
+// tokenFields 分词解析过程中识别出的各个时间字段，-1 表示未设置
+type tokenFields struct {
+	year, month, day        int
+	hour, minute, second    int
+	nsec, fracDigits        int
+	meridiem                string
+	loc                     *time.Location
+	weekday                 time.Weekday
+	hasWeekday, yearDigits2 bool
+	numbers                 []string
+	order                   DateOrder
+	ambiguous               bool   // 日与月可以互换
+	zoneAbbr                string // 时区缩写，按 zoneAbbrRule 确定偏移
+}
+
+// FromTokens 不依赖固定格式，按分词识别年、月、日、时间、上下午、时区等字段
+// 支持格式如: "Sat April 22 2023 6:22pm", "22 Apr 2023 18h22", "2023.4.22" 等
+func FromTokens(s string, opts ...func(*FromTokensOption)) (Result, error) {
+	cnf := new(FromTokensOption)
+	for _, opt := range opts {
+		opt(cnf)
+	}
+
+	f := &tokenFields{year: -1, month: -1, day: -1, hour: -1, minute: -1, second: -1, order: cnf.dateOrder}
+	tokens := tokenSplitter.Split(strings.ToLower(strings.TrimSpace(s)), -1)
+	for _, token := range tokens {
+		if token == "" {
+			continue
+		}
+		if !f.classify(token) && !cnf.skipUnknown {
+			return Result{}, newError(KindTokens, "token", ErrUnsupportedExpression, "unknown token %q", token)
+		}
+	}
+
+	if err := f.assignNumbers(); err != nil {
+		if !cnf.skipUnknown {
+			return Result{}, err
+		}
+	}
+	if cnf.strict && f.ambiguous {
+		return Result{}, newError(KindTokens, "date", ErrAmbiguous, "%s can be read as both DMY and MDY", s)
+	}
+	if f.year < 0 && f.month < 0 && f.day < 0 && f.hour < 0 {
+		return Result{}, newError(KindTokens, "", ErrUnsupportedExpression, "no date or time found")
+	}
+
+	return f.build(cnf)
+}
+
+// classify 识别单个分词，无法识别时返回 false
+func (f *tokenFields) classify(token string) bool {
+	if tokenFillers[token] {
+		return true
+	}
+	if token == "z" || token == "utc" || token == "gmt" {
+		f.loc = time.UTC
+		return true
+	}
+	if token == "am" || token == "pm" || token == "a.m." || token == "p.m." {
+		f.meridiem = token[:1]
+		return true
+	}
+	if m, ok := tokenMonths[strings.TrimSuffix(token, ".")]; ok && f.month < 0 {
+		f.month = int(m)
+		return true
+	}
+	if w, ok := tokenWeekdays[strings.TrimSuffix(token, ".")]; ok {
+		f.weekday, f.hasWeekday = w, true
+		return true
+	}
+	if _, ok := zoneAbbreviations[strings.ToUpper(token)]; ok && f.loc == nil && f.zoneAbbr == "" {
+		// 时区缩写，与按格式解析时的规则一致：缩写偏好、目标时区本身的缩写、缩写表
+		f.zoneAbbr = strings.ToUpper(token)
+		return true
+	}
+	if m := tokenTimeRe.FindStringSubmatch(token); m != nil && f.hour < 0 {
+		f.hour, _ = strconv.Atoi(m[1])
+		f.minute, _ = strconv.Atoi(m[2])
+		if m[3] != "" {
+			f.second, _ = strconv.Atoi(m[3])
+		}
+		if m[4] != "" {
+			f.fracDigits = len(m[4])
+			f.nsec, _ = strconv.Atoi(m[4] + strings.Repeat("0", 9-len(m[4])))
+		}
+		if m[5] != "" {
+			f.meridiem = m[5][:1]
+		}
+		return true
+	}
+	if m := tokenHourRe.FindStringSubmatch(token); m != nil && f.hour < 0 {
+		f.hour, _ = strconv.Atoi(m[1])
+		if m[2] != "" {
+			f.minute, _ = strconv.Atoi(m[2])
+		}
+		if m[3] != "" {
+			f.second, _ = strconv.Atoi(m[3])
+		}
+		return true
+	}
+	if m := tokenClockRe.FindStringSubmatch(token); m != nil && f.hour < 0 {
+		f.hour, _ = strconv.Atoi(m[1])
+		f.meridiem = m[2][:1]
+		return true
+	}
+	if m := tokenDateRe.FindStringSubmatch(token); m != nil && m[2] == m[4] && f.year < 0 && f.month < 0 && f.day < 0 {
+		return f.assignDate(m[1], m[3], m[5])
+	}
+	if m := tokenOffsetRe.FindStringSubmatch(token); m != nil && f.loc == nil && (f.hour >= 0 || strings.HasPrefix(token, "utc") || strings.HasPrefix(token, "gmt")) {
+		hours, _ := strconv.Atoi(m[2])
+		minutes, _ := strconv.Atoi(m[3])
+		offset := hours*3600 + minutes*60
+		if m[1] == "-" {
+			offset = -offset
+		}
+		f.loc = time.FixedZone("", offset)
+		return true
+	}
+	if m := tokenNumberRe.FindStringSubmatch(token); m != nil {
+		f.numbers = append(f.numbers, m[1])
+		return true
+	}
+	return false
+}
+
+// assignDate 识别以 . / - 分隔的数字日期
+func (f *tokenFields) assignDate(a, b, c string) bool {
+	first, _ := strconv.Atoi(a)
+	second, _ := strconv.Atoi(b)
+	third, _ := strconv.Atoi(c)
+
+	switch {
+	case len(a) >= 3: // 年-月-日
+		f.year, f.month, f.day = first, second, third
+	case len(c) >= 2:
+		f.assignDayMonth(first, second)
+		f.year, f.yearDigits2 = third, len(c) == 2
+	default:
+		return false
+	}
+	return true
+}
+
+// assignDayMonth 按日期顺序分配日与月
+// 未指定顺序时默认为月-日，首位大于 12 时视为日-月
+func (f *tokenFields) assignDayMonth(first, second int) {
+	dayFirst := f.order == DateOrderDMY || (f.order != DateOrderMDY && first > 12)
+	if dayFirst {
+		f.day, f.month = first, second
+	} else {
+		f.month, f.day = first, second
+	}
+	f.ambiguous = ambiguousDayMonth(f.day, f.month)
+}
+
+// assignNumbers 将剩余的纯数字分配为年、日、月
+func (f *tokenFields) assignNumbers() error {
+	rest := make([]string, 0, len(f.numbers))
+	for _, n := range f.numbers {
+		v, _ := strconv.Atoi(n)
+		if f.year < 0 && (len(n) >= 3 || v > 31) {
+			f.year, f.yearDigits2 = v, len(n) <= 2
+			continue
+		}
+		rest = append(rest, n)
+	}
+
+	if f.month < 0 && f.day < 0 && len(rest) > 1 {
+		first, _ := strconv.Atoi(rest[0])
+		second, _ := strconv.Atoi(rest[1])
+		f.assignDayMonth(first, second)
+		rest = rest[2:]
+	}
+
+	for _, n := range rest {
+		v, _ := strconv.Atoi(n)
+		switch {
+		case f.day < 0:
+			f.day = v
+		case f.month < 0 && v <= 12:
+			f.month = v
+		case f.year < 0:
+			f.year, f.yearDigits2 = v, len(n) <= 2
+		default:
+			return newError(KindTokens, "number", ErrUnsupportedExpression, "unexpected number %q", n)
+		}
+	}
+	return nil
+}
+
+// build 由识别出的字段构造时间，未指定年份时缺失的日期字段取基准时间
+func (f *tokenFields) build(cnf *FromTokensOption) (Result, error) {
+	base := time.Now()
+	if cnf.baseTime != nil {
+		base = *cnf.baseTime
+	}
+	loc := time.Local
+	if cnf.loc != nil {
+		loc = cnf.loc
+	}
+	if f.loc != nil {
+		loc = f.loc
+	}
+	base = base.In(loc)
+
+	precision := PrecisionYear
+	year, month, day := base.Date()
+	if f.year >= 0 {
+		// 指定年份时，缺失的月、日取 1 月 1 日，与 ISO 8601 仅有年份时一致
+		year, month, day = f.year, time.January, 1
+		if f.yearDigits2 {
+			year = cnf.twoDigitYear.Resolve(year, base)
+		}
+	}
+	if f.month >= 0 {
+		month, precision = time.Month(f.month), PrecisionMonth
+	}
+	if f.day >= 0 {
+		day, precision = f.day, PrecisionDay
+	} else if f.month >= 0 {
+		day = 1
+	}
+
+	hour, minute, second := 0, 0, 0
+	if f.hour >= 0 {
+		hour, precision = f.hour, PrecisionHour
+		switch f.meridiem {
+		case "a":
+			if hour > 12 || hour == 0 {
+				return Result{}, newError(KindTokens, "hour", ErrOutOfRange, "invalid hour for am: %d", hour)
+			}
+			if hour == 12 {
+				hour = 0
+			}
+		case "p":
+			if hour > 12 || hour == 0 {
+				return Result{}, newError(KindTokens, "hour", ErrOutOfRange, "invalid hour for pm: %d", hour)
+			}
+			if hour < 12 {
+				hour += 12
+			}
+		}
+	}
+	if f.minute >= 0 {
+		minute, precision = f.minute, PrecisionMinute
+	}
+	if f.second >= 0 {
+		second, precision = f.second, fractionPrecision(f.fracDigits)
+	}
+
+	if component := outOfRange(year, month, day, hour, minute, second); component != "" {
+		return Result{}, newError(KindTokens, component, ErrOutOfRange,
+			"%04d-%02d-%02d %02d:%02d:%02d", year, month, day, hour, minute, second)
+	}
+
+	at := time.Date(year, month, day, hour, minute, second, f.nsec, loc)
+	if f.zoneAbbr != "" {
+		zone, ok := abbrLocation(f.zoneAbbr, loc)
+		if !ok {
+			return Result{}, newError(KindTokens, "zone", ErrUnsupportedExpression, "unknown time zone abbreviation: %s", f.zoneAbbr)
+		}
+		var err error
+		rule := zoneAbbrRule{zones: cnf.zones, loc: loc, strict: cnf.strict, kind: KindTokens}
+		if at, err = rule.resolve(wallClockIn(at, zone)); err != nil {
+			return Result{}, err
+		}
+	}
+	if f.hasWeekday && f.day >= 0 && at.Weekday() != f.weekday {
+		return Result{}, newError(KindTokens, "weekday", ErrOutOfRange, "weekday %s does not match date %s", f.weekday, at.Format(time.DateOnly))
+	}
+
+	return Result{Time: at, Kind: KindTokens, Precision: precision}, nil
+}
@@ -0,0 +1,64 @@
+package parse
+
+import (
+	"strings"
+	"time"
+)
+
+type FromTokensOption struct {
+	baseTime    *time.Time
+	loc         *time.Location
+	skipUnknown bool
+	dateOrder   DateOrder
+	strict      bool
+	zones       map[string]*time.Location // 时区缩写偏好
+
+	twoDigitYear TwoDigitYear
+}
+
+func WithFromTokensBaseTime(base time.Time) func(*FromTokensOption) {
+	return func(o *FromTokensOption) {
+		if !base.IsZero() {
+			o.baseTime = &base
+		}
+	}
+}
+
+func WithFromTokensLocation(loc *time.Location) func(*FromTokensOption) {
+	return func(o *FromTokensOption) {
+		o.loc = loc
+	}
+}
+
+func WithFromTokensSkipUnknown(skip bool) func(*FromTokensOption) {
+	return func(o *FromTokensOption) {
+		o.skipUnknown = skip
+	}
+}
+
+func WithFromTokensDateOrder(order DateOrder) func(*FromTokensOption) {
+	return func(o *FromTokensOption) {
+		o.dateOrder = order
+	}
+}
+
+func WithFromTokensStrict(strict bool) func(*FromTokensOption) {
+	return func(o *FromTokensOption) {
+		o.strict = strict
+	}
+}
+
+func WithFromTokensTwoDigitYear(rule TwoDigitYear) func(*FromTokensOption) {
+	return func(o *FromTokensOption) {
+		o.twoDigitYear = rule
+	}
+}
+
+func WithFromTokensZonePreference(abbr string, loc *time.Location) func(*FromTokensOption) {
+	return func(o *FromTokensOption) {
+		if o.zones == nil {
+			o.zones = make(map[string]*time.Location)
+		}
+		o.zones[strings.ToUpper(abbr)] = loc
+	}
+}
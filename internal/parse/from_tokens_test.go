@@ -0,0 +1,99 @@
+package parse_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gomooth/chronos/internal/parse"
+)
+
+func TestFromTokens(t *testing.T) {
+	baseTime := time.Date(2023, 5, 15, 12, 0, 0, 0, time.UTC)
+	withBase := []func(*parse.FromTokensOption){parse.WithFromTokensBaseTime(baseTime), parse.WithFromTokensLocation(time.UTC)}
+	withSkip := append([]func(*parse.FromTokensOption){parse.WithFromTokensSkipUnknown(true)}, withBase...)
+
+	tests := []struct {
+		name      string
+		expr      string
+		opts      []func(*parse.FromTokensOption)
+		expected  time.Time
+		precision parse.Precision
+		wantErr   bool
+	}{
+		// 日期与时间
+		{"weekday month day year pm", "Sat April 22 2023 6:22pm", withBase, time.Date(2023, 4, 22, 18, 22, 0, 0, time.UTC), parse.PrecisionMinute, false},
+		{"day month year h-time", "22 Apr 2023 18h22", withBase, time.Date(2023, 4, 22, 18, 22, 0, 0, time.UTC), parse.PrecisionMinute, false},
+		{"dotted date", "2023.4.22", withBase, time.Date(2023, 4, 22, 0, 0, 0, 0, time.UTC), parse.PrecisionDay, false},
+		{"month first numeric date", "4/22/2023", withBase, time.Date(2023, 4, 22, 0, 0, 0, 0, time.UTC), parse.PrecisionDay, false},
+		{"day first when day > 12", "22-04-2023", withBase, time.Date(2023, 4, 22, 0, 0, 0, 0, time.UTC), parse.PrecisionDay, false},
+		{"comma separated", "April 22, 2023 at 6:22:15.5 pm", withBase, time.Date(2023, 4, 22, 18, 22, 15, 500000000, time.UTC), parse.PrecisionMillisecond, false},
+		{"ordinal day", "the 22nd of April 2023", withBase, time.Date(2023, 4, 22, 0, 0, 0, 0, time.UTC), parse.PrecisionDay, false},
+		{"month and year", "April 2023", withBase, time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC), parse.PrecisionMonth, false},
+		{"two digit year", "22 Apr 23", withBase, time.Date(2023, 4, 22, 0, 0, 0, 0, time.UTC), parse.PrecisionDay, false},
+		{"12am", "Apr 22 2023 12am", withBase, time.Date(2023, 4, 22, 0, 0, 0, 0, time.UTC), parse.PrecisionHour, false},
+		{"12 pm", "Apr 22 2023 12:30 PM", withBase, time.Date(2023, 4, 22, 12, 30, 0, 0, time.UTC), parse.PrecisionMinute, false},
+
+		// 缺省日期取基准时间
+		{"time only", "6:22pm", withBase, time.Date(2023, 5, 15, 18, 22, 0, 0, time.UTC), parse.PrecisionMinute, false},
+		{"month day only", "April 22", withBase, time.Date(2023, 4, 22, 0, 0, 0, 0, time.UTC), parse.PrecisionDay, false},
+		{"year only", "2023", withBase, time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), parse.PrecisionYear, false},
+		{"month year", "May 2023", withBase, time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC), parse.PrecisionMonth, false},
+
+		// 时区
+		{"utc", "22 Apr 2023 18:22 UTC", withBase, time.Date(2023, 4, 22, 18, 22, 0, 0, time.UTC), parse.PrecisionMinute, false},
+		{"offset", "22 Apr 2023 18:22 +0800", withBase, time.Date(2023, 4, 22, 10, 22, 0, 0, time.UTC), parse.PrecisionMinute, false},
+		{"gmt offset", "22 Apr 2023 18:22 GMT-5", withBase, time.Date(2023, 4, 22, 23, 22, 0, 0, time.UTC), parse.PrecisionMinute, false},
+		{"year abbreviation", "2023 PST", withBase, time.Date(2023, 1, 1, 8, 0, 0, 0, time.UTC), parse.PrecisionYear, false},
+		{"cet", "22 Apr 2023 CET", withBase, time.Date(2023, 4, 21, 23, 0, 0, 0, time.UTC), parse.PrecisionDay, false},
+		{"time abbreviation", "22 Apr 2023 18:22 jst", withBase, time.Date(2023, 4, 22, 9, 22, 0, 0, time.UTC), parse.PrecisionMinute, false},
+		{"ambiguous abbreviation default", "22 Apr 2023 18:22 CST", withBase, time.Date(2023, 4, 23, 0, 22, 0, 0, time.UTC), parse.PrecisionMinute, false},
+		{"ambiguous abbreviation strict", "22 Apr 2023 18:22 CST", append([]func(*parse.FromTokensOption){parse.WithFromTokensStrict(true)}, withBase...), time.Time{}, 0, true},
+		{"abbreviation preference", "22 Apr 2023 18:22 CST", append([]func(*parse.FromTokensOption){parse.WithFromTokensZonePreference("cst", time.FixedZone("CST", 8*3600))}, withBase...), time.Date(2023, 4, 22, 10, 22, 0, 0, time.UTC), parse.PrecisionMinute, false},
+		{"abbreviation preference strict", "22 Apr 2023 18:22 CST", append([]func(*parse.FromTokensOption){parse.WithFromTokensStrict(true), parse.WithFromTokensZonePreference("CST", time.FixedZone("CST", 8*3600))}, withBase...), time.Date(2023, 4, 22, 10, 22, 0, 0, time.UTC), parse.PrecisionMinute, false},
+
+		// 跳过未知词
+		{"unknown word", "Meeting on Sat April 22 2023 6:22pm", withBase, time.Time{}, 0, true},
+		{"skip unknown word", "Meeting on Sat April 22 2023 6:22pm", withSkip, time.Date(2023, 4, 22, 18, 22, 0, 0, time.UTC), parse.PrecisionMinute, false},
+
+		// 异常情况
+		{"empty", "", withBase, time.Time{}, 0, true},
+		{"weekday mismatch", "Fri April 22 2023", withBase, time.Time{}, 0, true},
+		{"invalid day", "April 31 2023", withBase, time.Time{}, 0, true},
+		{"invalid pm hour", "Apr 22 2023 13pm", withBase, time.Time{}, 0, true},
+		{"too many numbers", "22 4 2023 7", withBase, time.Time{}, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parse.FromTokens(tt.expr, tt.opts...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("FromTokens() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if !got.Equal(tt.expected) {
+				t.Errorf("FromTokens() = %v, want %v", got.Time, tt.expected)
+			}
+			if got.Precision != tt.precision {
+				t.Errorf("FromTokens() precision = %v, want %v", got.Precision, tt.precision)
+			}
+		})
+	}
+}
+
+func TestFromTokensZoneAbbrInLocation(t *testing.T) {
+	chicago, err := time.LoadLocation("America/Chicago")
+	if err != nil {
+		t.Skip("time zone database not available")
+	}
+
+	got, err := parse.FromTokens("22 Apr 2023 18:22 CDT", parse.WithFromTokensLocation(chicago))
+	if err != nil {
+		t.Fatalf("FromTokens() error = %v", err)
+	}
+	if want := time.Date(2023, 4, 22, 18, 22, 0, 0, chicago); !got.Time.Equal(want) || got.Location() != chicago {
+		t.Errorf("FromTokens() = %v, want %v", got.Time, want)
+	}
+}
@@ -1,45 +1,155 @@
 package parse
 
 import (
-	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
 	"time"
 )
 
+// 各单位自动识别的上限（绝对值），每个单位覆盖约 1973 ~ 5138 年
+const (
+	maxAutoSeconds      = 1e11
+	maxAutoMilliseconds = 1e14
+	maxAutoMicroseconds = 1e17
+)
+
+// 合理时间范围：0001-01-01T00:00:00Z ~ 9999-12-31T23:59:59Z
+const (
+	minPlausibleUnix = -62135596800
+	maxPlausibleUnix = 253402300799
+)
+
 // FromUnixTime 解析Unix时间戳
-func FromUnixTime(v any) (time.Time, error) {
-	var sec, nsec int64
-
-	switch val := v.(type) {
-	case int:
-		sec, nsec = int64(val), 0
-	case int16:
-		sec, nsec = int64(val), 0
-	case int32:
-		sec, nsec = int64(val), 0
-	case int64:
-		if val > 1e18 { // 纳秒
-			sec, nsec = 0, val
-		} else if val > 1e15 { // 微秒
-			sec, nsec = 0, val*1e3
-		} else if val > 1e12 { // 毫秒
-			sec, nsec = 0, val*1e6
-		} else {
-			sec, nsec = val, 0
+// 未指定单位时，按绝对值大小依次识别为秒、毫秒、微秒、纳秒，支持负数（1970 年之前）
+func FromUnixTime(v any, opts ...func(*FromUnixOption)) (Result, error) {
+	cnf := new(FromUnixOption)
+	for _, opt := range opts {
+		opt(cnf)
+	}
+
+	val, err := toInt64(v)
+	if err != nil {
+		return Result{}, err
+	}
+
+	return fromUnix(val, false, "", cnf)
+}
+
+// FromNumericString 解析数字形式的时间戳字符串，如 "1672643045"、"1672643045123"、"1672643045.123456"
+// 单位识别规则与 FromUnixTime 一致，小数部分按识别出的单位保留至纳秒精度
+func FromNumericString(s string, opts ...func(*FromUnixOption)) (Result, error) {
+	cnf := new(FromUnixOption)
+	for _, opt := range opts {
+		opt(cnf)
+	}
+
+	intPart, fracPart, ok := splitNumeric(s)
+	if !ok {
+		return Result{}, newError(KindTimestamp, "", ErrUnsupportedExpression, "not a numeric timestamp: %s", s)
+	}
+	val, err := strconv.ParseInt(intPart, 10, 64)
+	if err != nil {
+		return Result{}, newError(KindTimestamp, "value", ErrOutOfRange, "invalid numeric timestamp: %s", s)
+	}
+
+	return fromUnix(val, strings.HasPrefix(intPart, "-"), fracPart, cnf)
+}
+
+// fromUnix 按单位将整数部分及小数部分转换为时间
+func fromUnix(val int64, negative bool, frac string, cnf *FromUnixOption) (Result, error) {
+	unit := cnf.unit
+	if unit == UnitNone {
+		unit = detectUnit(val)
+	}
+
+	var at time.Time
+	var scale int // 该单位下小数部分最多可保留的位数
+	switch unit {
+	case UnitSecond:
+		at, scale = time.Unix(val, 0), 9
+	case UnitMillisecond:
+		at, scale = time.UnixMilli(val), 6
+	case UnitMicrosecond:
+		at, scale = time.UnixMicro(val), 3
+	case UnitNanosecond:
+		at, scale = time.Unix(0, val), 0
+	default:
+		return Result{}, newError(KindTimestamp, "unit", ErrUnknownUnit, "timestamp unit %d", unit)
+	}
+
+	if len(frac) > scale {
+		frac = frac[:scale]
+	}
+	if frac != "" {
+		nsec, _ := strconv.ParseInt(frac+strings.Repeat("0", scale-len(frac)), 10, 64)
+		if negative {
+			nsec = -nsec
 		}
-	case uint:
-		sec, nsec = int64(val), 0
-	case uint16:
-		sec, nsec = int64(val), 0
-	case uint32:
-		sec, nsec = int64(val), 0
-	case uint64:
-		if val > 1<<63-1 {
-			return time.Time{}, fmt.Errorf("uint64 value too large: %d", val)
+		at = at.Add(time.Duration(nsec))
+	}
+
+	if sec := at.Unix(); sec < minPlausibleUnix || sec > maxPlausibleUnix {
+		return Result{}, newError(KindTimestamp, "value", ErrOutOfRange, "timestamp %d as %s", val, unit)
+	}
+
+	return Result{
+		Time:      at,
+		Kind:      KindTimestamp,
+		Unit:      unit,
+		Precision: fractionPrecision(9 - scale + len(frac)),
+	}, nil
+}
+
+// detectUnit 根据数值大小推断时间戳单位
+func detectUnit(val int64) Unit {
+	abs := val
+	if abs < 0 {
+		if abs == math.MinInt64 {
+			return UnitNanosecond
 		}
-		sec, nsec = int64(val), 0
-	case uintptr:
-		sec, nsec = int64(val), 0
+		abs = -abs
 	}
 
-	return time.Unix(sec, nsec), nil
+	switch {
+	case abs < maxAutoSeconds:
+		return UnitSecond
+	case abs < maxAutoMilliseconds:
+		return UnitMillisecond
+	case abs < maxAutoMicroseconds:
+		return UnitMicrosecond
+	default:
+		return UnitNanosecond
+	}
+}
+
+// splitNumeric 拆分 [+-]digits[.digits] 形式的字符串
+func splitNumeric(s string) (intPart, fracPart string, ok bool) {
+	intPart, fracPart, hasDot := strings.Cut(s, ".")
+	digits := strings.TrimLeft(intPart, "+-")
+	if len(intPart)-len(digits) > 1 || !isDigits(digits) {
+		return "", "", false
+	}
+	if hasDot && !isDigits(fracPart) {
+		return "", "", false
+	}
+	return intPart, fracPart, true
+}
+
+// toInt64 将任意整数类型（包括以整数为底层类型的自定义类型）转为 int64
+func toInt64(v any) (int64, error) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u := rv.Uint()
+		if u > math.MaxInt64 {
+			return 0, newError(KindTimestamp, "value", ErrOutOfRange, "%s value too large: %d", rv.Type(), u)
+		}
+		return int64(u), nil
+	default:
+		return 0, newError(KindUnknown, "", ErrUnsupportedExpression, "unsupported timestamp type: %T", v)
+	}
 }
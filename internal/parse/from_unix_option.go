@@ -0,0 +1,11 @@
+package parse
+
+type FromUnixOption struct {
+	unit Unit
+}
+
+func WithFromUnixUnit(unit Unit) func(*FromUnixOption) {
+	return func(o *FromUnixOption) {
+		o.unit = unit
+	}
+}
@@ -0,0 +1,464 @@
+package parse
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// LayoutConflictError 部分样本无法按推断出的格式解析
+type LayoutConflictError struct {
+	Layout  string   // 按多数样本推断出的格式
+	Indexes []int    // 冲突样本的下标
+	Samples []string // 冲突样本
+}
+
+func (e *LayoutConflictError) Error() string {
+	return fmt.Sprintf("%d sample(s) do not match layout %q: %q", len(e.Samples), e.Layout, e.Samples)
+}
+
+const (
+	sampleDigits = 'd'
+	sampleAlpha  = 'a'
+	sampleZone   = 'z' // 数字偏移或 Z，如 "+08:00"、"-0700"、"Z"
+	sampleSep    = 's'
+)
+
+// sampleToken 样本中的一段：连续数字、连续字母、时区偏移或单个其他字符
+type sampleToken struct {
+	kind  byte
+	text  string
+	class string // 字母的类别：month、weekday、ampm、abbr 或 "=" 加小写原文
+}
+
+// InferLayout 根据样本推断 Go 时间格式
+// 样本按结构分组，取样本最多的一组推断格式，日与月的顺序由大于 12 的值确定；
+// 推断出的格式无法解析全部样本时，同时返回该格式及 *LayoutConflictError
+func InferLayout(samples []string) (string, error) {
+	tokenized := make([][]sampleToken, 0, len(samples))
+	groups := make(map[string][]int)
+	var order []string
+	for i, s := range samples {
+		tokens := tokenizeSample(strings.TrimSpace(s))
+		tokenized = append(tokenized, tokens)
+		if len(tokens) == 0 {
+			continue
+		}
+		sig := sampleSignature(tokens)
+		if _, ok := groups[sig]; !ok {
+			order = append(order, sig)
+		}
+		groups[sig] = append(groups[sig], i)
+	}
+	if len(order) == 0 {
+		return "", newError(KindLayout, "", ErrUnsupportedExpression, "no samples")
+	}
+
+	// 样本最多的一组，数量相同时取先出现的一组
+	major := order[0]
+	for _, sig := range order[1:] {
+		if len(groups[sig]) > len(groups[major]) {
+			major = sig
+		}
+	}
+	group := make([][]sampleToken, 0, len(groups[major]))
+	for _, i := range groups[major] {
+		group = append(group, tokenized[i])
+	}
+
+	layout, err := inferGroupLayout(group)
+	if err != nil {
+		err.Input = strings.TrimSpace(samples[groups[major][0]])
+		return "", err
+	}
+
+	conflict := &LayoutConflictError{Layout: layout}
+	for i, s := range samples {
+		if _, err := time.Parse(layout, strings.TrimSpace(s)); err != nil {
+			conflict.Indexes = append(conflict.Indexes, i)
+			conflict.Samples = append(conflict.Samples, s)
+		}
+	}
+	if len(conflict.Samples) > 0 {
+		return layout, conflict
+	}
+	return layout, nil
+}
+
+// tokenizeSample 将样本拆分为数字、字母及其他字符，时间之后的 +hh:mm、Z 等合并为时区
+func tokenizeSample(s string) []sampleToken {
+	var tokens []sampleToken
+	for i := 0; i < len(s); {
+		j := i + 1
+		kind := byte(sampleSep)
+		switch {
+		case isDigit(s[i]):
+			kind = sampleDigits
+			for j < len(s) && isDigit(s[j]) {
+				j++
+			}
+		case isLetter(s[i]):
+			kind = sampleAlpha
+			for j < len(s) && isLetter(s[j]) {
+				j++
+			}
+		case s[i] >= 0x80:
+			// 非 ASCII 字符整体作为分隔符
+			for j < len(s) && !utf8.RuneStart(s[j]) {
+				j++
+			}
+		}
+		tokens = append(tokens, sampleToken{kind: kind, text: s[i:j]})
+		i = j
+	}
+
+	// 合并时区偏移：仅紧跟在时间（分、秒、小数秒或 AM/PM，中间最多一个空格）之后的 Z、+hh、-hh:mm 视为时区
+	merged := tokens[:0]
+	clock := false    // 上一个字符段是时间的结尾
+	spaced := false   // 时间结尾之后已有一个空格
+	fraction := false // 上一个字符段是时间结尾之后的 . 或 ,，其后的数字为小数秒
+	for i := 0; i < len(tokens); i++ {
+		t := tokens[i]
+		prev := ""
+		if len(merged) > 0 {
+			prev = merged[len(merged)-1].text
+		}
+		wasClock, wasSpaced, wasFraction := clock, spaced, fraction
+		clock, spaced, fraction = false, false, false
+		switch {
+		case wasClock && t.kind == sampleAlpha && t.text == "Z":
+			t.kind = sampleZone
+		case wasClock && (t.text == "+" || t.text == "-") && i+1 < len(tokens) && tokens[i+1].kind == sampleDigits:
+			t.kind, t.text = sampleZone, t.text+tokens[i+1].text
+			i++
+			if i+2 < len(tokens) && tokens[i+1].text == ":" && tokens[i+2].kind == sampleDigits {
+				t.text += ":" + tokens[i+2].text
+				i += 2
+			}
+		case wasClock && !wasSpaced && t.text == " ":
+			clock, spaced = true, true
+		case wasClock && !wasSpaced && (t.text == "." || t.text == ","):
+			fraction = true
+		case t.kind == sampleDigits:
+			// 冒号之后的分、秒，T 之后的紧凑时间，以及小数秒
+			clock = prev == ":" || prev == "T" || wasFraction
+		case wasClock && t.kind == sampleAlpha && alphaClass(t.text) == "ampm":
+			clock = true
+		}
+		if t.kind == sampleAlpha {
+			t.class = alphaClass(t.text)
+		}
+		merged = append(merged, t)
+	}
+	return merged
+}
+
+// alphaClass 字母的类别，同一位置类别相同的样本视为同一结构
+func alphaClass(s string) string {
+	lower := strings.ToLower(s)
+	if _, ok := tokenMonths[lower]; ok {
+		return "month"
+	}
+	if _, ok := tokenWeekdays[lower]; ok {
+		return "weekday"
+	}
+	if lower == "am" || lower == "pm" {
+		return "ampm"
+	}
+	if len(s) >= 3 && len(s) <= 5 && strings.ToUpper(s) == s {
+		return "abbr"
+	}
+	return "=" + lower
+}
+
+func sampleSignature(tokens []sampleToken) string {
+	var b strings.Builder
+	for _, t := range tokens {
+		b.WriteByte(t.kind)
+		switch t.kind {
+		case sampleSep:
+			b.WriteString(t.text)
+		case sampleAlpha:
+			b.WriteString(t.class)
+		}
+		b.WriteByte('|')
+	}
+	return b.String()
+}
+
+// inferGroupLayout 推断结构相同的一组样本的格式
+func inferGroupLayout(group [][]sampleToken) (string, *ParseError) {
+	first := group[0]
+	column := func(i int) []string {
+		values := make([]string, len(group))
+		for j, tokens := range group {
+			values[j] = tokens[i].text
+		}
+		return values
+	}
+	// next 下一个非空格的位置
+	next := func(i int) int {
+		for i++; i < len(first) && first[i].text == " "; i++ {
+		}
+		return i
+	}
+
+	twelveHour := false
+	hasMonthName := false
+	for _, t := range first {
+		twelveHour = twelveHour || t.class == "ampm"
+		hasMonthName = hasMonthName || t.class == "month"
+	}
+
+	parts := make([]string, len(first))
+	var dateColumns []int
+	field := 0 // 已识别的时间字段：1 时、2 分、3 秒、4 小数
+	for i, t := range first {
+		prev := ""
+		if i > 0 {
+			prev = first[i-1].text
+		}
+		values := column(i)
+
+		switch t.kind {
+		case sampleSep:
+			parts[i] = t.text
+		case sampleZone:
+			parts[i] = zoneLayout(values)
+		case sampleAlpha:
+			parts[i] = alphaLayout(t.class, values)
+		default:
+			n := next(i)
+			switch {
+			case field == 0 && (i+1 < len(first) && first[i+1].text == ":" || twelveHour && n < len(first) && first[n].class == "ampm"):
+				field, parts[i] = 1, "15"
+				if twelveHour {
+					parts[i] = padded(values, "03", "3")
+				}
+			case field == 1 && prev == ":":
+				field, parts[i] = 2, padded(values, "04", "4")
+			case field == 2 && prev == ":":
+				field, parts[i] = 3, padded(values, "05", "5")
+			case field == 3 && (prev == "." || prev == ","):
+				field, parts[i] = 4, fractionLayout(values)
+			case sameLength(values, 14) && len(dateColumns) == 0:
+				field, parts[i] = 3, "20060102150405"
+			case sameLength(values, 12) && len(dateColumns) == 0:
+				field, parts[i] = 2, "200601021504"
+			case sameLength(values, 8) && len(dateColumns) == 0:
+				parts[i] = "20060102"
+				dateColumns = append(dateColumns, -1)
+			case prev == "T" && sameLength(values, 6):
+				field, parts[i] = 3, "150405"
+			case prev == "T" && sameLength(values, 4):
+				field, parts[i] = 2, "1504"
+			default:
+				dateColumns = append(dateColumns, i)
+			}
+		}
+	}
+
+	if field == 0 && len(dateColumns) == 0 && !hasMonthName {
+		return "", newError(KindLayout, "", ErrUnsupportedExpression, "no date or time found")
+	}
+	if len(dateColumns) > 0 && dateColumns[0] == -1 {
+		if len(dateColumns) > 1 {
+			return "", newError(KindLayout, "date", ErrUnsupportedExpression, "unexpected number after compact date")
+		}
+	} else if err := assignDateColumns(parts, dateColumns, column, hasMonthName); err != nil {
+		return "", err
+	}
+	return strings.Join(parts, ""), nil
+}
+
+// assignDateColumns 为日期部分的数字分配年、月、日
+func assignDateColumns(parts []string, cols []int, column func(int) []string, hasMonthName bool) *ParseError {
+	year := func(values []string) string {
+		if sameLength(values, 2) {
+			return "06"
+		}
+		return "2006"
+	}
+	isYear := func(values []string) bool {
+		for _, v := range values {
+			if len(v) >= 3 || atoi(v) > 31 {
+				return true
+			}
+		}
+		return false
+	}
+
+	if hasMonthName {
+		yearDone, dayDone := false, false
+		for _, i := range cols {
+			values := column(i)
+			switch {
+			case !yearDone && isYear(values):
+				parts[i], yearDone = "2006", true
+			case !dayDone:
+				parts[i], dayDone = padded(values, "02", "2"), true
+			case !yearDone:
+				parts[i], yearDone = year(values), true
+			default:
+				return newError(KindLayout, "date", ErrUnsupportedExpression, "too many numbers in date")
+			}
+		}
+		return nil
+	}
+
+	switch len(cols) {
+	case 0:
+		return nil
+	case 1:
+		if values := column(cols[0]); sameLength(values, 4) {
+			parts[cols[0]] = "2006"
+			return nil
+		}
+	case 2:
+		a, b := column(cols[0]), column(cols[1])
+		switch {
+		case sameLength(a, 4):
+			parts[cols[0]], parts[cols[1]] = "2006", padded(b, "01", "1")
+			return nil
+		case sameLength(b, 4):
+			parts[cols[0]], parts[cols[1]] = padded(a, "01", "1"), "2006"
+			return nil
+		}
+	case 3:
+		a, b, c := column(cols[0]), column(cols[1]), column(cols[2])
+		if isYear(a) {
+			parts[cols[0]], parts[cols[1]], parts[cols[2]] = year(a), padded(b, "01", "1"), padded(c, "02", "2")
+			return nil
+		}
+
+		// 日与月的顺序由大于 12 的值确定，两种均有时按多数，少数样本作为冲突返回
+		dayFirst, monthFirst := countAbove(a, 12), countAbove(b, 12)
+		if dayFirst == 0 && monthFirst == 0 {
+			return newError(KindLayout, "date", ErrAmbiguous, "day and month order cannot be determined, no value is greater than 12")
+		}
+		parts[cols[2]] = year(c)
+		if dayFirst >= monthFirst {
+			parts[cols[0]], parts[cols[1]] = padded(a, "02", "2"), padded(b, "01", "1")
+		} else {
+			parts[cols[0]], parts[cols[1]] = padded(a, "01", "1"), padded(b, "02", "2")
+		}
+		return nil
+	}
+	return newError(KindLayout, "date", ErrUnsupportedExpression, "cannot identify year, month and day")
+}
+
+// alphaLayout 月份、星期、上下午、时区缩写按多数样本的写法选择格式，其他字母原样保留
+func alphaLayout(class string, values []string) string {
+	// "May" 既是完整名称也是缩写，不参与判断
+	long, short := 0, 0
+	for _, v := range values {
+		switch {
+		case len(v) > 3:
+			long++
+		case !strings.EqualFold(v, "may"):
+			short++
+		}
+	}
+	switch class {
+	case "month":
+		if long > short {
+			return "January"
+		}
+		return "Jan"
+	case "weekday":
+		if long > short {
+			return "Monday"
+		}
+		return "Mon"
+	case "ampm":
+		upper := 0
+		for _, v := range values {
+			if v[0] >= 'A' && v[0] <= 'Z' {
+				upper++
+			}
+		}
+		if upper*2 >= len(values) {
+			return "PM"
+		}
+		return "pm"
+	case "abbr":
+		return "MST"
+	}
+	return values[0]
+}
+
+// zoneLayout 按多数样本的偏移写法选择格式，含 Z 时使用 Z07:00 等形式
+func zoneLayout(values []string) string {
+	counts := map[int]int{}
+	hasZ := false
+	for _, v := range values {
+		if v == "Z" {
+			hasZ = true
+			continue
+		}
+		counts[len(v)]++
+	}
+	size := 6
+	for n, c := range counts {
+		if c > counts[size] {
+			size = n
+		}
+	}
+	prefix := "-"
+	if hasZ {
+		prefix = "Z"
+	}
+	switch size {
+	case 3:
+		return prefix + "07"
+	case 5:
+		return prefix + "0700"
+	}
+	return prefix + "07:00"
+}
+
+// fractionLayout 小数位数一致时保留固定位数，否则按实际位数
+func fractionLayout(values []string) string {
+	if sameLength(values, len(values[0])) {
+		return strings.Repeat("0", len(values[0]))
+	}
+	return "999999999"
+}
+
+// padded 全部为两位数字时使用补零的格式
+func padded(values []string, zero, plain string) string {
+	if sameLength(values, 2) {
+		return zero
+	}
+	return plain
+}
+
+func sameLength(values []string, n int) bool {
+	for _, v := range values {
+		if len(v) != n {
+			return false
+		}
+	}
+	return true
+}
+
+func countAbove(values []string, limit int) int {
+	count := 0
+	for _, v := range values {
+		if atoi(v) > limit {
+			count++
+		}
+	}
+	return count
+}
+
+func atoi(s string) int {
+	n, _ := strconv.Atoi(s)
+	return n
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
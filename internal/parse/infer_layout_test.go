@@ -0,0 +1,58 @@
+package parse_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gomooth/chronos/internal/parse"
+)
+
+func TestInferLayout(t *testing.T) {
+	tests := []struct {
+		name     string
+		samples  []string
+		expected string
+		wantErr  error
+	}{
+		{"ymd", []string{"2023-04-22", "2023-12-01"}, "2006-01-02", nil},
+		{"dmy", []string{"22/04/2023", "01/05/2023"}, "02/01/2006", nil},
+		{"mdy unpadded", []string{"4/22/2023", "12/1/2023"}, "1/2/2006", nil},
+		{"two digit year", []string{"22.04.23 18:22", "01.05.23 07:05"}, "02.01.06 15:04", nil},
+		{"month name 12 hour", []string{"Apr 22, 2023 6:22 PM", "May 1, 2023 11:05 AM"}, "Jan 2, 2006 3:04 PM", nil},
+		{"full names", []string{"Saturday, 22 April 2023", "Monday, 1 May 2023"}, "Monday, 2 January 2006", nil},
+		{"iso with zone", []string{"2023-04-22T18:22:15.123+08:00", "2023-04-22T10:22:15.456Z"}, "2006-01-02T15:04:05.000Z07:00", nil},
+		{"variable fraction", []string{"2023-04-22 18:22:15.1 +0800", "2023-04-22 18:22:15.12345 -0700"}, "2006-01-02 15:04:05.999999999 -0700", nil},
+		{"compact", []string{"20230422", "20231201"}, "20060102", nil},
+		{"compact date time", []string{"20230422T182215Z"}, "20060102T150405Z07:00", nil},
+		{"unix date", []string{"Sat Apr 22 18:22:15 CST 2023"}, "Mon Jan 02 15:04:05 MST 2006", nil},
+		{"time before date", []string{"18:22 2023-04-22"}, "15:04 2006-01-02", nil},
+		{"time before date with zone", []string{"18:22:15 +0800 2023-04-22"}, "15:04:05 -0700 2006-01-02", nil},
+		{"12 hour with zone", []string{"Apr 22, 2023 6:22 PM -0700"}, "Jan 02, 2006 3:04 PM -0700", nil},
+		{"ambiguous", []string{"04/05/2023"}, "", parse.ErrAmbiguous},
+		{"no date", []string{"hello"}, "", parse.ErrUnsupportedExpression},
+		{"empty", nil, "", parse.ErrUnsupportedExpression},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parse.InferLayout(tt.samples)
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Fatalf("InferLayout(%q) error = %v, want %v", tt.samples, err, tt.wantErr)
+			}
+			if got != tt.expected {
+				t.Errorf("InferLayout(%q) = %q, want %q", tt.samples, got, tt.expected)
+			}
+		})
+	}
+
+	t.Run("conflict", func(t *testing.T) {
+		got, err := parse.InferLayout([]string{"22-Apr-2023 18:22:15", "2023-04-23", "01-May-2023 07:05:00"})
+		var conflict *parse.LayoutConflictError
+		if !errors.As(err, &conflict) {
+			t.Fatalf("error = %v, want LayoutConflictError", err)
+		}
+		if got != "02-Jan-2006 15:04:05" || len(conflict.Indexes) != 1 || conflict.Indexes[0] != 1 {
+			t.Errorf("got %q, conflicts %v", got, conflict.Indexes)
+		}
+	})
+}
@@ -0,0 +1,288 @@
+package parse
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gomooth/chronos/internal/helper"
+)
+
+// isoScanner 按 ISO 8601 语法逐字符扫描输入
+type isoScanner struct {
+	s string
+	i int
+}
+
+func (sc *isoScanner) done() bool {
+	return sc.i >= len(sc.s)
+}
+
+func (sc *isoScanner) peek() byte {
+	if sc.done() {
+		return 0
+	}
+	return sc.s[sc.i]
+}
+
+// accept 当前字符为 c 时前进一位
+func (sc *isoScanner) accept(c byte) bool {
+	if sc.peek() == c {
+		sc.i++
+		return true
+	}
+	return false
+}
+
+// countDigits 从当前位置开始连续数字的个数
+func (sc *isoScanner) countDigits() int {
+	n := 0
+	for j := sc.i; j < len(sc.s) && sc.s[j] >= '0' && sc.s[j] <= '9'; j++ {
+		n++
+	}
+	return n
+}
+
+// number 读取 n 位数字
+func (sc *isoScanner) number(n int) (int, bool) {
+	if sc.countDigits() < n {
+		return 0, false
+	}
+	v := 0
+	for j := 0; j < n; j++ {
+		v = v*10 + int(sc.s[sc.i+j]-'0')
+	}
+	sc.i += n
+	return v, true
+}
+
+// fraction 读取以 . 或 , 开头的小数部分，返回数值及位数（最多保留 9 位）
+func (sc *isoScanner) fraction() (value, digits int, ok bool) {
+	if c := sc.peek(); c != '.' && c != ',' {
+		return 0, 0, false
+	}
+	sc.i++
+	n := sc.countDigits()
+	if n == 0 {
+		return 0, 0, false
+	}
+	digits = n
+	if digits > 9 {
+		digits = 9
+	}
+	value, _ = sc.number(digits)
+	sc.i += n - digits
+	return value, digits, true
+}
+
+var pow10 = [...]int64{1, 10, 100, 1e3, 1e4, 1e5, 1e6, 1e7, 1e8, 1e9}
+
+// ParseISO8601 按 ISO 8601 语法解析日期时间，支持：
+//   - 日历日期：2023-04-22、20230422、2023-04、2023
+//   - 周日期：2023-W16-6、2023W166、2023-W16
+//   - 序数日期：2023-112、2023112
+//   - 时间：T18:22:15、T182215、T18:22、T1822、T18，最后一个字段可带 . 或 , 小数，如 T18.5、T18:22,5
+//   - 时区：Z、+08、+0800、+08:00
+//
+// 未带时区时使用 loc
+func ParseISO8601(s string, loc *time.Location) (Result, error) {
+	sc := &isoScanner{s: s}
+	failWith := func(err *ParseError) (Result, error) {
+		err.Err = fmt.Errorf("invalid ISO 8601 time %q: %w", s, err.Err)
+		return Result{}, err
+	}
+	fail := func(component string, sentinel error, reason string) (Result, error) {
+		return failWith(newError(KindISO8601, component, sentinel, "%s", reason))
+	}
+
+	year, ok := sc.number(4)
+	if !ok {
+		return fail("year", ErrUnsupportedExpression, "year must have 4 digits")
+	}
+
+	var date time.Time
+	precision := PrecisionDay
+	switch extended := sc.accept('-'); {
+	case sc.accept('W'):
+		week, ok := sc.number(2)
+		if !ok {
+			return fail("week", ErrUnsupportedExpression, "week must have 2 digits")
+		}
+		weekday := 1
+		if !extended || sc.accept('-') {
+			if sc.countDigits() == 1 {
+				weekday, _ = sc.number(1)
+			} else if extended {
+				return fail("weekday", ErrUnsupportedExpression, "weekday must have 1 digit")
+			}
+		}
+		if date, ok = isoWeekDate(year, week, weekday); !ok {
+			return fail("week", ErrOutOfRange, "week date out of range")
+		}
+	case sc.countDigits() == 3:
+		yday, _ := sc.number(3)
+		if yday < 1 || yday > time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC).YearDay() {
+			return fail("day", ErrOutOfRange, "day of year out of range")
+		}
+		date = time.Date(year, time.January, yday, 0, 0, 0, 0, time.UTC)
+	case extended:
+		month, ok := sc.number(2)
+		if !ok {
+			return fail("month", ErrUnsupportedExpression, "month must have 2 digits")
+		}
+		day := 1
+		if sc.accept('-') {
+			if day, ok = sc.number(2); !ok {
+				return fail("day", ErrUnsupportedExpression, "day must have 2 digits")
+			}
+		} else {
+			precision = PrecisionMonth
+		}
+		if date, ok = calendarDate(year, month, day); !ok {
+			return fail(outOfRange(year, time.Month(month), day, 0, 0, 0), ErrOutOfRange, "date out of range")
+		}
+	case sc.countDigits() == 4:
+		month, _ := sc.number(2)
+		day, _ := sc.number(2)
+		if date, ok = calendarDate(year, month, day); !ok {
+			return fail(outOfRange(year, time.Month(month), day, 0, 0, 0), ErrOutOfRange, "date out of range")
+		}
+	case sc.done():
+		date, precision = time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC), PrecisionYear
+	default:
+		return fail("date", ErrUnsupportedExpression, "unrecognised date")
+	}
+
+	var clock time.Duration
+	if sc.accept('T') {
+		if precision != PrecisionDay {
+			return fail("date", ErrUnsupportedExpression, "time requires a complete date")
+		}
+		var err *ParseError
+		if clock, precision, err = sc.isoTime(); err != nil {
+			return failWith(err)
+		}
+	}
+
+	if !sc.done() {
+		offset, utc, err := sc.isoZone()
+		if err != nil {
+			return failWith(err)
+		}
+		if utc {
+			loc = time.UTC
+		} else {
+			loc = time.FixedZone("", offset)
+		}
+	}
+	if !sc.done() {
+		return fail("", ErrUnsupportedExpression, "unexpected trailing characters")
+	}
+
+	// 以纳秒溢出的方式构造墙上时间，避免夏令时切换日按绝对时长相加产生偏差
+	y, m, d := date.Date()
+	at := time.Date(y, m, d, 0, 0, 0, int(clock), loc)
+	return Result{Time: at, Kind: KindISO8601, Precision: precision}, nil
+}
+
+// isoTimeFields 时间部分各字段的名称，用于错误信息
+var isoTimeFields = []string{"hour", "minute", "second"}
+
+// isoTime 解析 T 之后的时间部分，返回自当天零点起的时长
+func (sc *isoScanner) isoTime() (time.Duration, Precision, *ParseError) {
+	hour, ok := sc.number(2)
+	if !ok {
+		return 0, 0, newError(KindISO8601, "hour", ErrUnsupportedExpression, "hour must have 2 digits")
+	}
+	fields := []int{hour}
+	extended := sc.peek() == ':'
+	for len(fields) < 3 {
+		if extended && !sc.accept(':') {
+			break
+		}
+		v, ok := sc.number(2)
+		if !ok {
+			if extended {
+				return 0, 0, newError(KindISO8601, isoTimeFields[len(fields)], ErrUnsupportedExpression, "time fields must have 2 digits")
+			}
+			break
+		}
+		fields = append(fields, v)
+	}
+
+	units := []time.Duration{time.Hour, time.Minute, time.Second}
+	limits := []int{23, 59, 59}
+	var clock time.Duration
+	for i, v := range fields {
+		if v > limits[i] {
+			return 0, 0, newError(KindISO8601, isoTimeFields[i], ErrOutOfRange, "%s %02d", isoTimeFields[i], v)
+		}
+		clock += time.Duration(v) * units[i]
+	}
+
+	precision := []Precision{PrecisionHour, PrecisionMinute, PrecisionSecond}[len(fields)-1]
+	if frac, digits, ok := sc.fraction(); ok {
+		unit := units[len(fields)-1]
+		clock += time.Duration(int64(frac) * (int64(unit) / pow10[digits]))
+		switch len(fields) {
+		case 1:
+			precision = PrecisionMinute
+		case 2:
+			precision = PrecisionSecond
+		default:
+			precision = fractionPrecision(digits)
+		}
+	}
+	return clock, precision, nil
+}
+
+// isoZone 解析时区：Z 或 ±hh[[:]mm]
+func (sc *isoScanner) isoZone() (offset int, utc bool, err *ParseError) {
+	if sc.accept('Z') {
+		return 0, true, nil
+	}
+	sign := 1
+	switch {
+	case sc.accept('+'):
+	case sc.accept('-'):
+		sign = -1
+	default:
+		return 0, false, newError(KindISO8601, "zone", ErrUnsupportedExpression, "unrecognised time zone")
+	}
+
+	hours, ok := sc.number(2)
+	if !ok {
+		return 0, false, newError(KindISO8601, "zone", ErrUnsupportedExpression, "zone hour must have 2 digits")
+	}
+	minutes := 0
+	if sc.accept(':') || sc.countDigits() == 2 {
+		if minutes, ok = sc.number(2); !ok {
+			return 0, false, newError(KindISO8601, "zone", ErrUnsupportedExpression, "zone minute must have 2 digits")
+		}
+	}
+	if hours > 23 || minutes > 59 {
+		return 0, false, newError(KindISO8601, "zone", ErrOutOfRange, "zone offset out of range")
+	}
+	return sign * (hours*3600 + minutes*60), false, nil
+}
+
+// calendarDate 校验并构造日历日期
+func calendarDate(year, month, day int) (time.Time, bool) {
+	if month < 1 || month > 12 || day < 1 || day > helper.DaysInMonth(year, time.Month(month)) {
+		return time.Time{}, false
+	}
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC), true
+}
+
+// isoWeekDate 由 ISO 周日期构造日历日期，第 1 周为包含 1 月 4 日的那一周，周一为每周第 1 天
+func isoWeekDate(year, week, weekday int) (time.Time, bool) {
+	if week < 1 || weekday < 1 || weekday > 7 {
+		return time.Time{}, false
+	}
+	if _, lastWeek := time.Date(year, time.December, 28, 0, 0, 0, 0, time.UTC).ISOWeek(); week > lastWeek {
+		return time.Time{}, false
+	}
+
+	jan4 := time.Date(year, time.January, 4, 0, 0, 0, 0, time.UTC)
+	monday := jan4.AddDate(0, 0, -((int(jan4.Weekday()) + 6) % 7))
+	return monday.AddDate(0, 0, (week-1)*7+weekday-1), true
+}
@@ -0,0 +1,93 @@
+package parse_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gomooth/chronos/internal/parse"
+)
+
+func TestParseISO8601(t *testing.T) {
+	cst := time.FixedZone("", 8*3600)
+	pdt := time.FixedZone("", -7*3600)
+
+	tests := []struct {
+		name      string
+		input     string
+		expected  time.Time
+		precision parse.Precision
+		wantErr   bool
+	}{
+		// 日历日期
+		{"extended date", "2023-04-22", time.Date(2023, 4, 22, 0, 0, 0, 0, time.UTC), parse.PrecisionDay, false},
+		{"basic date", "20230422", time.Date(2023, 4, 22, 0, 0, 0, 0, time.UTC), parse.PrecisionDay, false},
+		{"year month", "2023-04", time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC), parse.PrecisionMonth, false},
+		{"year", "2023", time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), parse.PrecisionYear, false},
+
+		// 周日期
+		{"extended week date", "2023-W16-6", time.Date(2023, 4, 22, 0, 0, 0, 0, time.UTC), parse.PrecisionDay, false},
+		{"basic week date", "2023W166", time.Date(2023, 4, 22, 0, 0, 0, 0, time.UTC), parse.PrecisionDay, false},
+		{"week", "2023-W16", time.Date(2023, 4, 17, 0, 0, 0, 0, time.UTC), parse.PrecisionDay, false},
+		{"week 1 in previous year", "2020-W01-1", time.Date(2019, 12, 30, 0, 0, 0, 0, time.UTC), parse.PrecisionDay, false},
+		{"week 53", "2020-W53-7", time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC), parse.PrecisionDay, false},
+
+		// 序数日期
+		{"extended ordinal date", "2023-112", time.Date(2023, 4, 22, 0, 0, 0, 0, time.UTC), parse.PrecisionDay, false},
+		{"basic ordinal date", "2023112", time.Date(2023, 4, 22, 0, 0, 0, 0, time.UTC), parse.PrecisionDay, false},
+		{"leap ordinal date", "2024-366", time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC), parse.PrecisionDay, false},
+
+		// 时间
+		{"basic zulu", "20230422T182215Z", time.Date(2023, 4, 22, 18, 22, 15, 0, time.UTC), parse.PrecisionSecond, false},
+		{"basic offset", "20230422T1822+0800", time.Date(2023, 4, 22, 18, 22, 0, 0, cst), parse.PrecisionMinute, false},
+		{"offset without colon", "2023-04-22T18:22:15-0700", time.Date(2023, 4, 22, 18, 22, 15, 0, pdt), parse.PrecisionSecond, false},
+		{"hour offset", "2023-04-22T18:22+08", time.Date(2023, 4, 22, 18, 22, 0, 0, cst), parse.PrecisionMinute, false},
+		{"comma fraction", "2023-04-22T18:22:15,5Z", time.Date(2023, 4, 22, 18, 22, 15, 500000000, time.UTC), parse.PrecisionMillisecond, false},
+		{"fractional hour", "2023-04-22T18.5Z", time.Date(2023, 4, 22, 18, 30, 0, 0, time.UTC), parse.PrecisionMinute, false},
+		{"fractional minute", "2023-04-22T18:22.25Z", time.Date(2023, 4, 22, 18, 22, 15, 0, time.UTC), parse.PrecisionSecond, false},
+		{"basic fractional minute", "20230422T1822,5Z", time.Date(2023, 4, 22, 18, 22, 30, 0, time.UTC), parse.PrecisionSecond, false},
+		{"hour only", "2023-04-22T18", time.Date(2023, 4, 22, 18, 0, 0, 0, time.UTC), parse.PrecisionHour, false},
+		{"week date time", "2023-W16-6T18:22Z", time.Date(2023, 4, 22, 18, 22, 0, 0, time.UTC), parse.PrecisionMinute, false},
+		{"ordinal date time", "2023-112T18:22:15.123456Z", time.Date(2023, 4, 22, 18, 22, 15, 123456000, time.UTC), parse.PrecisionMicrosecond, false},
+		{"nanoseconds beyond 9 digits", "2023-04-22T18:22:15.1234567891Z", time.Date(2023, 4, 22, 18, 22, 15, 123456789, time.UTC), parse.PrecisionNanosecond, false},
+
+		// 异常情况
+		{"empty", "", time.Time{}, 0, true},
+		{"short year", "23-04-22", time.Time{}, 0, true},
+		{"invalid month", "2023-13-01", time.Time{}, 0, true},
+		{"invalid day", "2023-02-29", time.Time{}, 0, true},
+		{"invalid ordinal", "2023-366", time.Time{}, 0, true},
+		{"invalid week", "2023-W53-1", time.Time{}, 0, true},
+		{"invalid weekday", "2023-W16-8", time.Time{}, 0, true},
+		{"time after reduced date", "2023-04T18:22", time.Time{}, 0, true},
+		{"invalid hour", "2023-04-22T25:00", time.Time{}, 0, true},
+		{"invalid minute", "2023-04-22T18:60", time.Time{}, 0, true},
+		{"mixed time format", "2023-04-22T18:2215", time.Time{}, 0, true},
+		{"invalid zone", "2023-04-22T18:22+8", time.Time{}, 0, true},
+		{"trailing", "2023-04-22T18:22Zx", time.Time{}, 0, true},
+		{"six digit basic date", "202304", time.Time{}, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parse.ParseISO8601(tt.input, time.UTC)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseISO8601() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if !got.Equal(tt.expected) {
+				t.Errorf("ParseISO8601() = %v, want %v", got.Time, tt.expected)
+			}
+			_, gotOffset := got.Zone()
+			_, wantOffset := tt.expected.Zone()
+			if gotOffset != wantOffset {
+				t.Errorf("ParseISO8601() offset = %d, want %d", gotOffset, wantOffset)
+			}
+			if got.Precision != tt.precision {
+				t.Errorf("ParseISO8601() precision = %v, want %v", got.Precision, tt.precision)
+			}
+		})
+	}
+}
@@ -0,0 +1,250 @@
+package parse
+
+import (
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gomooth/chronos/internal/calculator"
+)
+
+// ISODuration ISO 8601 时长，如 "P1Y2M3DT4H5M6.5S"、"PT15M"、"P2W"
+// 年、月、周、日为日历部分，按日历计算，长度随所在月份、夏令时变化；时、分、秒为精确时长
+// 各部分均为非负数，符号由 Negative 表示
+type ISODuration struct {
+	Negative                   bool
+	Years, Months, Weeks, Days int
+	Time                       time.Duration // 时、分、秒部分
+}
+
+// ParseISODuration 解析 ISO 8601 时长，支持前导负号，如 "-P1D"
+// 小数仅允许出现在时、分、秒部分，以 . 或 , 分隔，如 "PT0.5H"、"PT6,5S"
+func ParseISODuration(s string) (ISODuration, error) {
+	fail := func(component string, sentinel error, reason string) (ISODuration, error) {
+		return ISODuration{}, newError(KindISO8601, component, sentinel, "invalid ISO 8601 duration %q: %s", s, reason)
+	}
+
+	var d ISODuration
+	rest := s
+	switch {
+	case strings.HasPrefix(rest, "-"):
+		d.Negative, rest = true, rest[1:]
+	case strings.HasPrefix(rest, "+"):
+		rest = rest[1:]
+	}
+	if !strings.HasPrefix(rest, "P") {
+		return fail("", ErrUnsupportedExpression, "missing P designator")
+	}
+	rest = rest[1:]
+
+	inTime := false
+	found := false
+	order := "YMWD" // 剩余可出现的单位，须按顺序出现
+	for rest != "" {
+		if rest[0] == 'T' {
+			if inTime {
+				return fail("", ErrUnsupportedExpression, "duplicate T designator")
+			}
+			inTime, order, rest = true, "HMS", rest[1:]
+			if rest == "" {
+				return fail("", ErrUnsupportedExpression, "missing time component after T")
+			}
+			continue
+		}
+
+		i := 0
+		for i < len(rest) && isDigit(rest[i]) {
+			i++
+		}
+		intPart, frac := rest[:i], ""
+		if i < len(rest) && (rest[i] == '.' || rest[i] == ',') {
+			j := i + 1
+			for j < len(rest) && isDigit(rest[j]) {
+				j++
+			}
+			frac, i = rest[i+1:j], j
+			if frac == "" {
+				return fail("", ErrUnsupportedExpression, "missing digits after decimal sign")
+			}
+		}
+		if intPart == "" || i == len(rest) {
+			return fail("", ErrUnsupportedExpression, "expected number followed by a unit designator")
+		}
+		unit := rest[i]
+		rest = rest[i+1:]
+
+		units := "YMWD"
+		if inTime {
+			units = "HMS"
+		}
+		if strings.IndexByte(units, unit) < 0 {
+			return fail("unit", ErrUnknownUnit, "unexpected designator "+string(unit))
+		}
+		pos := strings.IndexByte(order, unit)
+		if pos < 0 {
+			return fail(durationComponent(unit, inTime), ErrUnsupportedExpression, "designator "+string(unit)+" out of order")
+		}
+		order = order[pos+1:]
+		component := durationComponent(unit, inTime)
+		if frac != "" && rest != "" {
+			return fail("", ErrUnsupportedExpression, "only the last component may have a fraction")
+		}
+
+		if !inTime {
+			if frac != "" {
+				return fail(component, ErrUnsupportedExpression, "calendar components cannot have a fraction")
+			}
+			v, ok := durationInt(intPart)
+			if !ok {
+				return fail(component, ErrOutOfRange, "value too large")
+			}
+			switch unit {
+			case 'Y':
+				d.Years = v
+			case 'M':
+				d.Months = v
+			case 'W':
+				d.Weeks = v
+			case 'D':
+				d.Days = v
+			}
+		} else {
+			perUnit := map[byte]time.Duration{'H': time.Hour, 'M': time.Minute, 'S': time.Second}[unit]
+			v := new(big.Rat).SetInt(new(big.Int).Mul(decimalDigits(intPart), big.NewInt(int64(perUnit))))
+			if frac != "" {
+				v.Add(v, new(big.Rat).SetFrac(new(big.Int).Mul(decimalDigits(frac), big.NewInt(int64(perUnit))),
+					new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(len(frac))), nil)))
+			}
+			total := new(big.Rat).Add(v, new(big.Rat).SetInt64(int64(d.Time)))
+			if total.Cmp(new(big.Rat).SetInt64(math.MaxInt64)) > 0 {
+				return fail(component, ErrOutOfRange, "time components exceed the maximum duration")
+			}
+			d.Time = time.Duration(roundRat(total))
+		}
+		found = true
+	}
+	if !found {
+		return fail("", ErrUnsupportedExpression, "no components")
+	}
+	return d, nil
+}
+
+// durationComponent 单位标识对应的字段名称，用于错误信息
+func durationComponent(unit byte, inTime bool) string {
+	switch unit {
+	case 'Y':
+		return "year"
+	case 'M':
+		if inTime {
+			return "minute"
+		}
+		return "month"
+	case 'W':
+		return "week"
+	case 'D':
+		return "day"
+	case 'H':
+		return "hour"
+	default:
+		return "second"
+	}
+}
+
+// durationInt 解析日历部分的整数，超出 int32 范围时返回 false
+func durationInt(s string) (int, bool) {
+	v := decimalDigits(s)
+	if !v.IsInt64() || v.Int64() > math.MaxInt32 {
+		return 0, false
+	}
+	return int(v.Int64()), true
+}
+
+// AddTo 将时长加到时间上：先按日历加年、月、日，再加精确时长，月末溢出规则与 time.AddDate 一致
+func (d ISODuration) AddTo(t time.Time) time.Time {
+	sign := 1
+	if d.Negative {
+		sign = -1
+	}
+	if d.Years != 0 || d.Months != 0 || d.Weeks != 0 || d.Days != 0 {
+		t = calculator.AddDate(t, sign*d.Years, sign*d.Months, sign*(d.Weeks*7+d.Days))
+	}
+	return t.Add(time.Duration(sign) * d.Time)
+}
+
+// SubtractFrom 从时间上减去时长，即加上相反的时长
+func (d ISODuration) SubtractFrom(t time.Time) time.Time {
+	d.Negative = !d.Negative
+	return d.AddTo(t)
+}
+
+// String 格式化为 ISO 8601 时长，日历部分与精确时长分开输出，时长为零时返回 "PT0S"
+// 精确时长按时、分、秒输出，不换算为天，秒的小数部分去掉末尾的 0，如 "P1Y2M3DT4H5M6.5S"、"-PT0.5S"
+func (d ISODuration) String() string {
+	if d.Years == 0 && d.Months == 0 && d.Weeks == 0 && d.Days == 0 && d.Time == 0 {
+		return "PT0S"
+	}
+	var b strings.Builder
+	if d.Negative {
+		b.WriteByte('-')
+	}
+	b.WriteByte('P')
+	for _, c := range []struct {
+		value int
+		unit  byte
+	}{{d.Years, 'Y'}, {d.Months, 'M'}, {d.Weeks, 'W'}, {d.Days, 'D'}} {
+		if c.value != 0 {
+			b.WriteString(strconv.Itoa(c.value))
+			b.WriteByte(c.unit)
+		}
+	}
+
+	if d.Time != 0 {
+		b.WriteByte('T')
+		writeISOTime(&b, uint64(d.Time))
+	}
+	return b.String()
+}
+
+// writeISOTime 按时、分、秒写出纳秒数
+func writeISOTime(b *strings.Builder, ns uint64) {
+	const (
+		second = uint64(time.Second)
+		minute = uint64(time.Minute)
+		hour   = uint64(time.Hour)
+	)
+	if h := ns / hour; h > 0 {
+		b.WriteString(strconv.FormatUint(h, 10))
+		b.WriteByte('H')
+	}
+	if m := ns % hour / minute; m > 0 {
+		b.WriteString(strconv.FormatUint(m, 10))
+		b.WriteByte('M')
+	}
+	if sec, frac := ns%minute/second, ns%second; sec > 0 || frac > 0 {
+		b.WriteString(strconv.FormatUint(sec, 10))
+		if frac > 0 {
+			digits := strconv.FormatUint(frac+second, 10)[1:] // 补足 9 位
+			b.WriteByte('.')
+			b.WriteString(strings.TrimRight(digits, "0"))
+		}
+		b.WriteByte('S')
+	}
+}
+
+// FormatISODuration 将精确时长格式化为 ISO 8601 时长，如 "PT1H2M3S"、"-PT0.5S"，不换算为天
+func FormatISODuration(d time.Duration) string {
+	if d == 0 {
+		return "PT0S"
+	}
+	var b strings.Builder
+	ns := uint64(d)
+	if d < 0 {
+		b.WriteByte('-')
+		ns = -ns
+	}
+	b.WriteString("PT")
+	writeISOTime(&b, ns)
+	return b.String()
+}
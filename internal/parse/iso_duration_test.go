@@ -0,0 +1,107 @@
+package parse_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/gomooth/chronos/internal/parse"
+)
+
+func TestParseISODuration(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected parse.ISODuration
+		wantErr  error
+	}{
+		{"P1Y2M3DT4H5M6.5S", parse.ISODuration{Years: 1, Months: 2, Days: 3, Time: 4*time.Hour + 5*time.Minute + 6500*time.Millisecond}, nil},
+		{"PT15M", parse.ISODuration{Time: 15 * time.Minute}, nil},
+		{"P2W", parse.ISODuration{Weeks: 2}, nil},
+		{"-P1D", parse.ISODuration{Negative: true, Days: 1}, nil},
+		{"PT0,5H", parse.ISODuration{Time: 30 * time.Minute}, nil},
+		{"PT0.000000001S", parse.ISODuration{Time: 1}, nil},
+		{"P", parse.ISODuration{}, parse.ErrUnsupportedExpression},
+		{"P1DT", parse.ISODuration{}, parse.ErrUnsupportedExpression},
+		{"P1.5D", parse.ISODuration{}, parse.ErrUnsupportedExpression},
+		{"P0.5Y", parse.ISODuration{}, parse.ErrUnsupportedExpression},
+		{"PT1.5H30M", parse.ISODuration{}, parse.ErrUnsupportedExpression},
+		{"P1D2Y", parse.ISODuration{}, parse.ErrUnsupportedExpression},
+		{"PT1S2H", parse.ISODuration{}, parse.ErrUnsupportedExpression},
+		{"P1D1D", parse.ISODuration{}, parse.ErrUnsupportedExpression},
+		{"P1H", parse.ISODuration{}, parse.ErrUnknownUnit},
+		{"PT9999999999H", parse.ISODuration{}, parse.ErrOutOfRange},
+		{"1D", parse.ISODuration{}, parse.ErrUnsupportedExpression},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := parse.ParseISODuration(tt.input)
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Fatalf("ParseISODuration(%q) error = %v, want %v", tt.input, err, tt.wantErr)
+			}
+			if got != tt.expected {
+				t.Errorf("ParseISODuration(%q) = %+v, want %+v", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestISODurationAddTo(t *testing.T) {
+	at := time.Date(2023, 1, 31, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		input    string
+		expected time.Time
+	}{
+		{"P1M", time.Date(2023, 3, 3, 10, 0, 0, 0, time.UTC)},
+		{"P1Y2M3DT4H", time.Date(2024, 4, 3, 14, 0, 0, 0, time.UTC)},
+		{"-P1W", time.Date(2023, 1, 24, 10, 0, 0, 0, time.UTC)},
+		{"PT36H", time.Date(2023, 2, 1, 22, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			d, err := parse.ParseISODuration(tt.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := d.AddTo(at); !got.Equal(tt.expected) {
+				t.Errorf("AddTo = %v, want %v", got, tt.expected)
+			}
+			if got := d.SubtractFrom(d.AddTo(at)); d.Years == 0 && d.Months == 0 && !got.Equal(at) {
+				t.Errorf("SubtractFrom = %v, want %v", got, at)
+			}
+		})
+	}
+}
+
+func TestISODurationString(t *testing.T) {
+	// 格式化后再解析，结果一致
+	for _, s := range []string{"P1Y2M3DT4H5M6.5S", "PT15M", "P2W", "-P1D", "-PT0.000000001S", "P1MT1M", "PT48H", "PT0S"} {
+		t.Run(s, func(t *testing.T) {
+			d, err := parse.ParseISODuration(s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := d.String(); got != s {
+				t.Errorf("String() = %q, want %q", got, s)
+			}
+		})
+	}
+
+	tests := []struct {
+		input    time.Duration
+		expected string
+	}{
+		{0, "PT0S"},
+		{90 * time.Minute, "PT1H30M"},
+		{-1500 * time.Millisecond, "-PT1.5S"},
+		{26*time.Hour + 3*time.Second, "PT26H3S"},
+		{math.MinInt64, "-PT2562047H47M16.854775808S"},
+	}
+	for _, tt := range tests {
+		if got := parse.FormatISODuration(tt.input); got != tt.expected {
+			t.Errorf("FormatISODuration(%v) = %q, want %q", tt.input, got, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Keyword 关键字，由基准时间计算出对应的时间
+type Keyword struct {
+	Resolve   func(base time.Time) time.Time
+	Precision Precision // 关键字表示的精度，如 now 为瞬时、today 为某一天
+}
+
+var keywords = struct {
+	sync.RWMutex
+	m map[string]Keyword
+}{m: map[string]Keyword{
+	"now":       {Resolve: func(base time.Time) time.Time { return base }, Precision: PrecisionNanosecond},
+	"today":     {Resolve: func(base time.Time) time.Time { return startOfDay(base, 0) }, Precision: PrecisionDay},
+	"yesterday": {Resolve: func(base time.Time) time.Time { return startOfDay(base, -1) }, Precision: PrecisionDay},
+	"tomorrow":  {Resolve: func(base time.Time) time.Time { return startOfDay(base, 1) }, Precision: PrecisionDay},
+}}
+
+// RegisterKeyword 注册关键字，名称不区分大小写，已存在的同名关键字（包括内置关键字）将被覆盖
+func RegisterKeyword(name string, keyword Keyword) error {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" {
+		return fmt.Errorf("keyword name is empty")
+	}
+	if keyword.Resolve == nil {
+		return fmt.Errorf("keyword %q has no resolve function", name)
+	}
+
+	keywords.Lock()
+	defer keywords.Unlock()
+	keywords.m[name] = keyword
+	return nil
+}
+
+// FromKeyword 解析关键字，如 "now"、"today"、"yesterday"、"tomorrow" 及通过 RegisterKeyword 注册的关键字
+// 关键字按基准时间计算，未指定基准时间时取当前时间
+func FromKeyword(s string, opts ...func(*FromKeywordOption)) (Result, error) {
+	cnf := new(FromKeywordOption)
+	for _, opt := range opts {
+		opt(cnf)
+	}
+
+	keyword, ok := lookupKeyword(s)
+	if !ok {
+		return Result{}, newError(KindKeyword, "", ErrUnsupportedExpression, "unknown keyword: %s", s)
+	}
+
+	return keywordResult(keyword.Resolve(cnf.base()), keyword.Precision), nil
+}
+
+// IsKeyword 判断输入是否为内置或已注册的关键字
+func IsKeyword(s string) bool {
+	_, ok := lookupKeyword(s)
+	return ok
+}
+
+// BaseTime 按关键字选项确定的基准时间，未指定时取当前时间
+func BaseTime(opts ...func(*FromKeywordOption)) time.Time {
+	cnf := new(FromKeywordOption)
+	for _, opt := range opts {
+		opt(cnf)
+	}
+	return cnf.base()
+}
+
+func (cnf *FromKeywordOption) base() time.Time {
+	base := time.Now()
+	if cnf.baseTime != nil {
+		base = *cnf.baseTime
+	}
+	if cnf.loc != nil {
+		base = base.In(cnf.loc)
+	}
+	return base
+}
+
+func lookupKeyword(s string) (Keyword, bool) {
+	keywords.RLock()
+	defer keywords.RUnlock()
+	keyword, ok := keywords.m[strings.ToLower(strings.TrimSpace(s))]
+	return keyword, ok
+}
+
+func keywordResult(at time.Time, precision Precision) Result {
+	return Result{Time: at, Kind: KindKeyword, Precision: precision}
+}
+
+// startOfDay 相对 base 偏移若干天的零点
+func startOfDay(base time.Time, days int) time.Time {
+	y, m, d := base.Date()
+	return time.Date(y, m, d+days, 0, 0, 0, 0, base.Location())
+}
@@ -0,0 +1,58 @@
+package parse_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gomooth/chronos/internal/parse"
+)
+
+func TestFromKeyword(t *testing.T) {
+	// 2023-05-15 20:00 UTC 为东京时间 2023-05-16 05:00
+	baseTime := time.Date(2023, 5, 15, 20, 0, 0, 0, time.UTC)
+	locTokyo := time.FixedZone("JST", 9*3600)
+
+	tests := []struct {
+		name     string
+		expr     string
+		opts     []func(*parse.FromKeywordOption)
+		expected time.Time
+		wantErr  bool
+	}{
+		{"now", "now", []func(*parse.FromKeywordOption){parse.WithFromKeywordBaseTime(baseTime)}, baseTime, false},
+		{"today", " Today ", []func(*parse.FromKeywordOption){parse.WithFromKeywordBaseTime(baseTime)}, time.Date(2023, 5, 15, 0, 0, 0, 0, time.UTC), false},
+		{"today in tokyo", "today", []func(*parse.FromKeywordOption){parse.WithFromKeywordBaseTime(baseTime), parse.WithFromKeywordLocation(locTokyo)}, time.Date(2023, 5, 16, 0, 0, 0, 0, locTokyo), false},
+		{"yesterday across month", "yesterday", []func(*parse.FromKeywordOption){parse.WithFromKeywordBaseTime(time.Date(2023, 3, 1, 8, 0, 0, 0, time.UTC))}, time.Date(2023, 2, 28, 0, 0, 0, 0, time.UTC), false},
+		{"unknown", "someday", nil, time.Time{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parse.FromKeyword(tt.expr, tt.opts...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FromKeyword(%q) error = %v, wantErr %v", tt.expr, err, tt.wantErr)
+			}
+			if !tt.wantErr && !got.Equal(tt.expected) {
+				t.Errorf("FromKeyword(%q) = %v, want %v", tt.expr, got.Time, tt.expected)
+			}
+		})
+	}
+}
+
+func TestIsKeyword(t *testing.T) {
+	tests := []struct {
+		expr string
+		want bool
+	}{
+		{"now", true},
+		{" Tomorrow ", true},
+		{"someday", false},
+		{"2023-04-22", false},
+	}
+
+	for _, tt := range tests {
+		if got := parse.IsKeyword(tt.expr); got != tt.want {
+			t.Errorf("IsKeyword(%q) = %v, want %v", tt.expr, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package parse
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	ordinalRe      = regexp.MustCompile(`(?i)(\d)(st|nd|rd|th)\b`)
+	abbrPeriodRe   = regexp.MustCompile(`([A-Za-z])\.`)
+	commaRe        = regexp.MustCompile(`,(\s|$)`) // 逗号后跟数字时为秒的小数分隔符，保留
+	spaceRe        = regexp.MustCompile(`\s+`)
+	dateTimeSepRe  = regexp.MustCompile(`(\d)t(\d)`)
+	clockSuffixRe  = regexp.MustCompile(`\d:\d\d(:\d\d([.,]\d+)?)? ?[A-Za-z]{1,5}\b`) // 时间之后的 Z、AM/PM 及时区缩写
+	lenientLayouts = compileLayouts(humanDateLayouts())
+)
+
+// humanDateLayouts 宽松模式下额外尝试的手写日期格式，已去掉逗号，如 "April 22 2023"、"Sat Apr 22 2023"、"22 Apr 2023 18:22"
+func humanDateLayouts() []string {
+	layouts := make([]string, 0, 36)
+	for _, weekday := range []string{"", "Mon ", "Monday "} {
+		for _, month := range []string{"Jan", "January"} {
+			for _, date := range []string{month + " 2 2006", "2 " + month + " 2006"} {
+				for _, clock := range []string{"", " 15:04:05", " 15:04"} {
+					layouts = append(layouts, weekday+date+clock)
+				}
+			}
+		}
+	}
+	return layouts
+}
+
+// lenientLayout 按宽松模式的规则整理格式：去掉缩写后的句点及逗号，合并连续空白
+func lenientLayout(layout string) string {
+	layout = abbrPeriodRe.ReplaceAllString(layout, "$1")
+	layout = commaRe.ReplaceAllString(layout, " ")
+	return strings.TrimSpace(spaceRe.ReplaceAllString(layout, " "))
+}
+
+// lenientInput 按宽松模式的规则整理输入：先合并连续空白，再在 lenientLayout 的基础上去掉序数词后缀，
+// 并将日期与时间之间的 t、时间之后的 z、am/pm 及时区缩写转换为大写；月份、星期名称的匹配本就不区分大小写，末尾的 IANA 时区名保持不变
+func lenientInput(s string) string {
+	s = strings.TrimSpace(spaceRe.ReplaceAllString(s, " "))
+	rest, _ := splitZoneSuffix(s)
+	suffix := s[len(rest):]
+
+	rest = ordinalRe.ReplaceAllString(rest, "$1")
+	rest = dateTimeSepRe.ReplaceAllString(rest, "${1}T$2")
+	rest = clockSuffixRe.ReplaceAllStringFunc(rest, strings.ToUpper)
+	return lenientLayout(rest) + suffix
+}
+
+// parseLenient 按宽松模式整理输入及格式后重新解析
+// 整理后与原输入相同时仍需尝试，手写日期格式只在宽松模式下启用
+func (f *StringFormat) parseLenient(s string) (Result, bool) {
+	in := lenientInput(s)
+	lenient := *f
+	lenient.index = f.lenientIndex
+	res, err := lenient.parseNormalized(in)
+	return res, err == nil
+}
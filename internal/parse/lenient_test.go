@@ -0,0 +1,75 @@
+package parse_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gomooth/chronos/internal/parse"
+)
+
+func TestStringFormatLenient(t *testing.T) {
+	shanghai := time.FixedZone("UTC+8", 8*3600)
+	date := time.Date(2023, 4, 22, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		input string
+		opts  []func(*parse.FromStringOption)
+		want  time.Time
+	}{
+		{"whitespace and ordinal", " april 22nd, 2023 ", nil, date},
+		{"upper case ordinal", "22ND APR 2023", nil, date},
+		{"abbreviation periods", "Sat., Apr. 22, 2023", nil, date},
+		{"full weekday", "saturday, april 22, 2023", nil, date},
+		{"lower case separators", "2023-04-22t18:22:15z", nil, time.Date(2023, 4, 22, 18, 22, 15, 0, time.UTC)},
+		{"lower case offset", "2023-04-22t18:22:15+08:00", nil, time.Date(2023, 4, 22, 18, 22, 15, 0, shanghai)},
+		{"rfc1123 without comma", "sat 22 apr 2023 18:22:15 gmt", nil, time.Date(2023, 4, 22, 18, 22, 15, 0, time.UTC)},
+		{"multiple spaces", "sat,  22 apr 2023 18:22:15  gmt", nil, time.Date(2023, 4, 22, 18, 22, 15, 0, time.UTC)},
+		{"tabs before clock suffix", "april 22 2023 6:22\tpm", []func(*parse.FromStringOption){parse.WithFromStringLayout("January 2 2006 3:04 PM")}, time.Date(2023, 4, 22, 18, 22, 0, 0, time.UTC)},
+		{"kitchen lower case", "3:04pm", nil, time.Date(0, 1, 1, 15, 4, 0, 0, time.UTC)},
+		{"surrounding whitespace", "\t2023-04-22T18:22:15+08:00 ", nil, time.Date(2023, 4, 22, 18, 22, 15, 0, shanghai)},
+		{"date with time", "April 1st, 2023 09:30", nil, time.Date(2023, 4, 1, 9, 30, 0, 0, time.UTC)},
+		{"custom layout", "22nd of April, 2023", []func(*parse.FromStringOption){parse.WithFromStringLayout("2 of January, 2006")}, date},
+		{"human date needs no rewrite", "Sat 22 Apr 2023", nil, date},
+		{"zone suffix kept", "2023-04-22t18:22:15 Asia/Shanghai", nil, time.Date(2023, 4, 22, 10, 22, 15, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := append([]func(*parse.FromStringOption){parse.WithFromStringLocation(time.UTC)}, tt.opts...)
+			if _, err := parse.FromStringFormat(tt.input, opts...); err == nil {
+				t.Fatalf("FromStringFormat(%q) should fail without lenient mode", tt.input)
+			}
+
+			got, err := parse.FromStringFormat(tt.input, append(opts, parse.WithFromStringLenient(true))...)
+			if err != nil {
+				t.Fatalf("FromStringFormat(%q) error = %v", tt.input, err)
+			}
+			if !got.Time.Equal(tt.want) {
+				t.Errorf("FromStringFormat(%q) = %v, want %v", tt.input, got.Time, tt.want)
+			}
+		})
+	}
+}
+
+func TestStringFormatLenientPrecision(t *testing.T) {
+	tests := []struct {
+		input string
+		want  parse.Precision
+	}{
+		{"April 22nd, 2023", parse.PrecisionDay},
+		{"sat 22 apr 2023", parse.PrecisionDay},
+		{"April 1st, 2023 09:30", parse.PrecisionMinute},
+		{"sat,  22 apr 2023 18:22:15  gmt", parse.PrecisionSecond},
+	}
+
+	for _, tt := range tests {
+		got, err := parse.FromStringFormat(tt.input, parse.WithFromStringLocation(time.UTC), parse.WithFromStringLenient(true))
+		if err != nil {
+			t.Fatalf("FromStringFormat(%q) error = %v", tt.input, err)
+		}
+		if got.Precision != tt.want {
+			t.Errorf("FromStringFormat(%q) precision = %v, want %v", tt.input, got.Precision, tt.want)
+		}
+	}
+}
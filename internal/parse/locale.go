@@ -0,0 +1,332 @@
+package parse
+
+import (
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Locale 某一语言的月份、星期、上下午名称表，用于将输入翻译为英文后再解析
+type Locale struct {
+	Months   [12][]string // 1 月 ~ 12 月，完整名称在前，缩写在后
+	Weekdays [7][]string  // 周日 ~ 周六，完整名称在前，缩写在后
+	AM, PM   []string     // 可含点号与空格，如 "a. m."
+	Ignore   []string     // 无实际含义的词，如 "de"、"年"
+
+	// 数字加后缀形式的月份及时间，如 "4月"、"18时22分15秒"
+	MonthSuffix                              string
+	HourSuffixes, MinuteSuffix, SecondSuffix string
+
+	words      map[string]string // 小写名称 => 英文
+	maxWordLen int               // 最长名称的字节数
+	cjkTimeRe  *regexp.Regexp
+	monthRe    *regexp.Regexp
+	phraseRe   *regexp.Regexp // 含点号或空格的上下午名称，如 "p. m."
+}
+
+var locales = map[string]*Locale{
+	"en": {
+		Months: [12][]string{
+			{"january", "jan"}, {"february", "feb"}, {"march", "mar"}, {"april", "apr"},
+			{"may"}, {"june", "jun"}, {"july", "jul"}, {"august", "aug"},
+			{"september", "sept", "sep"}, {"october", "oct"}, {"november", "nov"}, {"december", "dec"},
+		},
+		Weekdays: [7][]string{
+			{"sunday", "sun"}, {"monday", "mon"}, {"tuesday", "tues", "tue"}, {"wednesday", "wed"},
+			{"thursday", "thurs", "thu"}, {"friday", "fri"}, {"saturday", "sat"},
+		},
+		AM: []string{"am"},
+		PM: []string{"pm"},
+	},
+	"zh-CN": {
+		Months: [12][]string{
+			{"一月"}, {"二月"}, {"三月"}, {"四月"}, {"五月"}, {"六月"},
+			{"七月"}, {"八月"}, {"九月"}, {"十月"}, {"十一月"}, {"十二月"},
+		},
+		Weekdays: [7][]string{
+			{"星期日", "星期天", "礼拜日", "礼拜天", "周日"},
+			{"星期一", "礼拜一", "周一"}, {"星期二", "礼拜二", "周二"}, {"星期三", "礼拜三", "周三"},
+			{"星期四", "礼拜四", "周四"}, {"星期五", "礼拜五", "周五"}, {"星期六", "礼拜六", "周六"},
+		},
+		AM:           []string{"上午", "早上", "凌晨"},
+		PM:           []string{"下午", "中午", "晚上", "傍晚"},
+		Ignore:       []string{"年", "日", "号"},
+		MonthSuffix:  "月",
+		HourSuffixes: "时点",
+		MinuteSuffix: "分",
+		SecondSuffix: "秒",
+	},
+	"ja": {
+		Months: [12][]string{
+			{"一月"}, {"二月"}, {"三月"}, {"四月"}, {"五月"}, {"六月"},
+			{"七月"}, {"八月"}, {"九月"}, {"十月"}, {"十一月"}, {"十二月"},
+		},
+		Weekdays: [7][]string{
+			// "日"、"月" 与日期后缀冲突，不作为单字缩写
+			{"日曜日", "日曜"}, {"月曜日", "月曜"}, {"火曜日", "火曜", "火"}, {"水曜日", "水曜", "水"},
+			{"木曜日", "木曜", "木"}, {"金曜日", "金曜", "金"}, {"土曜日", "土曜", "土"},
+		},
+		AM:           []string{"午前"},
+		PM:           []string{"午後"},
+		Ignore:       []string{"年", "日"},
+		MonthSuffix:  "月",
+		HourSuffixes: "時",
+		MinuteSuffix: "分",
+		SecondSuffix: "秒",
+	},
+	"fr": {
+		Months: [12][]string{
+			{"janvier", "janv"}, {"février", "fevrier", "févr", "fevr"}, {"mars"}, {"avril", "avr"},
+			{"mai"}, {"juin"}, {"juillet", "juil"}, {"août", "aout"},
+			{"septembre", "sept"}, {"octobre", "oct"}, {"novembre", "nov"}, {"décembre", "decembre", "déc", "dec"},
+		},
+		Weekdays: [7][]string{
+			{"dimanche", "dim"}, {"lundi", "lun"}, {"mardi", "mar"}, {"mercredi", "mer"},
+			{"jeudi", "jeu"}, {"vendredi", "ven"}, {"samedi", "sam"},
+		},
+		AM:     []string{"am"},
+		PM:     []string{"pm"},
+		Ignore: []string{"le", "de", "du", "à"},
+	},
+	"de": {
+		Months: [12][]string{
+			{"januar", "jänner", "jan"}, {"februar", "feb"}, {"märz", "maerz", "mär", "mrz"}, {"april", "apr"},
+			{"mai"}, {"juni", "jun"}, {"juli", "jul"}, {"august", "aug"},
+			{"september", "sept", "sep"}, {"oktober", "okt"}, {"november", "nov"}, {"dezember", "dez"},
+		},
+		Weekdays: [7][]string{
+			{"sonntag", "so"}, {"montag", "mo"}, {"dienstag", "di"}, {"mittwoch", "mi"},
+			{"donnerstag", "do"}, {"freitag", "fr"}, {"samstag", "sonnabend", "sa"},
+		},
+		AM:     []string{"vorm", "vormittags"},
+		PM:     []string{"nachm", "nachmittags", "abends"},
+		Ignore: []string{"am", "den", "der", "um", "uhr"},
+	},
+	"es": {
+		Months: [12][]string{
+			{"enero", "ene"}, {"febrero", "feb"}, {"marzo", "mar"}, {"abril", "abr"},
+			{"mayo", "may"}, {"junio", "jun"}, {"julio", "jul"}, {"agosto", "ago"},
+			{"septiembre", "setiembre", "sept", "sep"}, {"octubre", "oct"}, {"noviembre", "nov"}, {"diciembre", "dic"},
+		},
+		Weekdays: [7][]string{
+			{"domingo", "dom"}, {"lunes", "lun"}, {"martes"}, {"miércoles", "miercoles", "mié", "mie"},
+			{"jueves", "jue"}, {"viernes", "vie"}, {"sábado", "sabado", "sáb", "sab"},
+		},
+		AM:     []string{"am", "a. m.", "a.m."},
+		PM:     []string{"pm", "p. m.", "p.m."},
+		Ignore: []string{"de", "del", "el", "la", "las"},
+	},
+}
+
+func init() {
+	for _, l := range locales {
+		l.compile()
+	}
+}
+
+// LookupLocale 查找语言表，名称不区分大小写，"_" 与 "-" 等价，未找到时尝试仅按语言部分查找，如 "fr-CA" => "fr"
+func LookupLocale(name string) (*Locale, bool) {
+	name = strings.ReplaceAll(name, "_", "-")
+	for key, l := range locales {
+		if strings.EqualFold(key, name) {
+			return l, true
+		}
+	}
+	if lang, _, ok := strings.Cut(name, "-"); ok {
+		for key, l := range locales {
+			if k, _, _ := strings.Cut(key, "-"); strings.EqualFold(k, lang) {
+				return l, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// compile 生成名称查找表
+func (l *Locale) compile() {
+	l.words = make(map[string]string)
+	add := func(names []string, full, abbr string) {
+		for i, name := range names {
+			// 第一个为完整名称，其余为缩写
+			if i == 0 {
+				l.addWord(name, full)
+			} else {
+				l.addWord(name, abbr)
+			}
+		}
+	}
+	for i, names := range l.Months {
+		m := time.Month(i + 1).String()
+		add(names, m, m[:3])
+	}
+	for i, names := range l.Weekdays {
+		w := time.Weekday(i).String()
+		add(names, w, w[:3])
+	}
+	var phrases []string
+	for _, names := range [][]string{l.AM, l.PM} {
+		for _, name := range names {
+			if strings.ContainsAny(strings.TrimSuffix(name, "."), ". ") {
+				phrases = append(phrases, phrasePattern(name))
+			}
+		}
+	}
+	for _, name := range l.AM {
+		l.addWord(phraseKey(name), "AM")
+	}
+	for _, name := range l.PM {
+		l.addWord(phraseKey(name), "PM")
+	}
+	for _, name := range l.Ignore {
+		l.addWord(name, "")
+	}
+
+	if len(phrases) > 0 {
+		l.phraseRe = regexp.MustCompile(`(?i)\b(?:` + strings.Join(phrases, "|") + `)`)
+	}
+	if l.MonthSuffix != "" {
+		l.monthRe = regexp.MustCompile(`(\d{1,2})\s*` + regexp.QuoteMeta(l.MonthSuffix))
+	}
+	if l.HourSuffixes != "" {
+		l.cjkTimeRe = regexp.MustCompile(`(\d{1,2})\s*[` + l.HourSuffixes + `](?:\s*(\d{1,2})\s*` + l.MinuteSuffix +
+			`(?:\s*(\d{1,2})\s*` + l.SecondSuffix + `)?)?`)
+	}
+}
+
+// phraseKey 去掉名称中的空格，如 "p. m." => "p.m."
+func phraseKey(name string) string {
+	return strings.Join(strings.Fields(name), "")
+}
+
+// phrasePattern 名称对应的正则，点号之后允许任意空白，末尾的点号可省略
+func phrasePattern(name string) string {
+	pattern := regexp.QuoteMeta(strings.TrimSuffix(phraseKey(name), "."))
+	return strings.ReplaceAll(pattern, `\.`, `\.\s*`) + `\.?`
+}
+
+func (l *Locale) addWord(name, english string) {
+	name = strings.ToLower(strings.TrimSuffix(name, "."))
+	l.words[name] = english
+	if len(name) > l.maxWordLen {
+		l.maxWordLen = len(name)
+	}
+}
+
+// Translate 将输入中的本地化名称替换为英文，如 "sam. 22 avr. 2023" => "Sat 22 Apr 2023"
+// 拉丁字母的词需要整词匹配，中日文按最长匹配切分
+func (l *Locale) Translate(s string) string {
+	if l.phraseRe != nil {
+		s = l.phraseRe.ReplaceAllStringFunc(s, func(m string) string {
+			return " " + l.words[strings.ToLower(strings.TrimSuffix(phraseKey(m), "."))] + " "
+		})
+	}
+	if l.monthRe != nil {
+		s = l.monthRe.ReplaceAllStringFunc(s, func(m string) string {
+			sub := l.monthRe.FindStringSubmatch(m)
+			month := 0
+			for _, c := range sub[1] {
+				month = month*10 + int(c-'0')
+			}
+			if month < 1 || month > 12 {
+				return m
+			}
+			return " " + time.Month(month).String() + " "
+		})
+	}
+	if l.cjkTimeRe != nil {
+		s = l.cjkTimeRe.ReplaceAllStringFunc(s, func(m string) string {
+			sub := l.cjkTimeRe.FindStringSubmatch(m)
+			clock := " " + sub[1] + ":" + pad2(sub[2])
+			if sub[3] != "" {
+				clock += ":" + pad2(sub[3])
+			}
+			return clock + " "
+		})
+	}
+
+	var b strings.Builder
+	b.Grow(len(s))
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if !unicode.IsLetter(r) {
+			b.WriteString(s[i : i+size])
+			i += size
+			continue
+		}
+
+		// 取出连续的字母
+		j := i
+		latin := true
+		for j < len(s) {
+			r, size := utf8.DecodeRuneInString(s[j:])
+			if !unicode.IsLetter(r) {
+				break
+			}
+			if !unicode.Is(unicode.Latin, r) {
+				latin = false
+			}
+			j += size
+		}
+		if latin {
+			l.writeLatin(&b, s, i, j)
+		} else {
+			l.writeCJK(&b, s[i:j])
+		}
+		if j < len(s) && s[j] == '.' && l.isWord(s[i:j]) {
+			// 去掉缩写之后的点，如 "avr."
+			j++
+		}
+		i = j
+	}
+	return strings.Join(strings.Fields(b.String()), " ")
+}
+
+func (l *Locale) isWord(word string) bool {
+	_, ok := l.words[strings.ToLower(word)]
+	return ok
+}
+
+// writeLatin 整词匹配拉丁字母组成的词
+func (l *Locale) writeLatin(b *strings.Builder, s string, i, j int) {
+	if english, ok := l.words[strings.ToLower(s[i:j])]; ok {
+		b.WriteString(english)
+		return
+	}
+	b.WriteString(s[i:j])
+}
+
+// writeCJK 按最长匹配切分中日文，中日文与数字之间没有空格，替换后的英文前后补充空格
+func (l *Locale) writeCJK(b *strings.Builder, run string) {
+	for run != "" {
+		matched := false
+		for n := min(len(run), l.maxWordLen); n > 0; n-- {
+			if n < len(run) && !utf8.RuneStart(run[n]) {
+				continue
+			}
+			if english, ok := l.words[run[:n]]; ok {
+				b.WriteString(" " + english + " ")
+				run = run[n:]
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			_, size := utf8.DecodeRuneInString(run)
+			b.WriteString(run[:size])
+			run = run[size:]
+		}
+	}
+}
+
+func pad2(s string) string {
+	switch len(s) {
+	case 0:
+		return "00"
+	case 1:
+		return "0" + s
+	default:
+		return s
+	}
+}
@@ -0,0 +1,51 @@
+package parse_test
+
+import (
+	"testing"
+
+	"github.com/gomooth/chronos/internal/parse"
+)
+
+func TestLocaleTranslate(t *testing.T) {
+	tests := []struct {
+		locale   string
+		input    string
+		expected string
+	}{
+		{"fr", "22 avril 2023", "22 April 2023"},
+		{"fr", "sam., 22 avr. 2023 18:22:15 +0200", "Sat, 22 Apr 2023 18:22:15 +0200"},
+		{"fr", "samedi 22 août 2023 à 18h22", "Saturday 22 August 2023 18h22"},
+		{"fr", "mardi 7 mars 2023", "Tuesday 7 March 2023"},
+		{"de", "22. April 2023", "22. April 2023"},
+		{"de", "Samstag, 22. März 2023 um 18:22 Uhr", "Saturday, 22. March 2023 18:22"},
+		{"de", "Sa., 22. Okt. 2023", "Sat, 22. Oct 2023"},
+		{"es", "sábado, 22 de abril de 2023", "Saturday, 22 April 2023"},
+		{"es", "22 dic. 2023 6:22 pm", "22 Dec 2023 6:22 PM"},
+		{"es", "22 dic. 2023 6:22 p. m.", "22 Dec 2023 6:22 PM"},
+		{"es", "22 dic. 2023 6:22 a.m.", "22 Dec 2023 6:22 AM"},
+		{"es", "22 dic. 2023, 6:22 P. M.", "22 Dec 2023, 6:22 PM"},
+		{"zh-CN", "2023年4月22日 星期六", "2023 April 22 Saturday"},
+		{"zh-CN", "2023年04月22日 18时22分15秒", "2023 April 22 18:22:15"},
+		{"zh-CN", "2023年十二月22日 周六 下午3点15分", "2023 December 22 Sat PM 3:15"},
+		{"zh_cn", "4月22日 晚上8点", "April 22 PM 8:00"},
+		{"ja", "2023年4月22日(土曜日) 午後6時22分", "2023 April 22 ( Saturday ) PM 6:22"},
+		{"en", "Sat April 22 2023", "Sat April 22 2023"},
+		{"en-GB", "22 sept. 2023", "22 Sep 2023"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.locale+" "+tt.input, func(t *testing.T) {
+			l, ok := parse.LookupLocale(tt.locale)
+			if !ok {
+				t.Fatalf("LookupLocale(%q) not found", tt.locale)
+			}
+			if got := l.Translate(tt.input); got != tt.expected {
+				t.Errorf("Translate() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+
+	if _, ok := parse.LookupLocale("xx"); ok {
+		t.Errorf("LookupLocale(%q) should not be found", "xx")
+	}
+}
@@ -0,0 +1,65 @@
+package parse
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+// LeapSecond 闰秒 23:59:60 的处理策略
+type LeapSecond int
+
+const (
+	LeapSecondReject   LeapSecond = iota // 不支持，按超出范围返回错误
+	LeapSecondClamp                      // 截断为 23:59:59.999999999
+	LeapSecondRollOver                   // 顺延为次日 00:00:00
+)
+
+var (
+	// 24:00、24:00:00、24:00:00.000，秒及小数部分必须为 0
+	endOfDayRe = regexp.MustCompile(`(^|[^\d])24:00(:00([.,]0+)?)?([^\d:.,]|$)`)
+	// hh:59:60，时区偏移不是整点时闰秒也不在 23 时，秒之后可以带小数
+	leapSecondRe = regexp.MustCompile(`(^|[^\d])\d{1,2}:59:60([^\d:]|$)`)
+)
+
+// normalizeClock 将 24:00 改写为 23:59，将闰秒改写为 59 秒，以便按格式解析，返回改写后的输入及规范化类型
+func normalizeClock(s string, leap LeapSecond) (string, Normalization) {
+	if m := endOfDayRe.FindStringSubmatchIndex(s); m != nil {
+		start, end := m[3], m[8]
+		var b strings.Builder
+		b.WriteString(s[:start])
+		b.WriteString("23:59")
+		if m[4] >= 0 {
+			b.WriteString(":59")
+		}
+		if m[6] >= 0 {
+			b.WriteByte(s[m[6]])
+			b.WriteString(strings.Repeat("9", m[7]-m[6]-1))
+		}
+		b.WriteString(s[end:])
+		return b.String(), NormalizationEndOfDay
+	}
+
+	if leap != LeapSecondReject {
+		if m := leapSecondRe.FindStringSubmatchIndex(s); m != nil {
+			second := m[4] - 2
+			return s[:second] + "59" + s[second+2:], NormalizationLeapSecond
+		}
+	}
+	return s, NormalizationNone
+}
+
+// applyNormalization 由改写后输入的解析结果得到规范化后的时间
+func (f *StringFormat) applyNormalization(res Result, n Normalization) Result {
+	t := res.Time
+	switch {
+	case n == NormalizationEndOfDay:
+		res.Time = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+	case n == NormalizationLeapSecond && f.leap == LeapSecondClamp:
+		res.Time = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), 59, 999999999, t.Location())
+	case n == NormalizationLeapSecond:
+		res.Time = t.Add(time.Second)
+	}
+	res.Normalized = n
+	return res
+}
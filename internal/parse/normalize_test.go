@@ -0,0 +1,60 @@
+package parse_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gomooth/chronos/internal/parse"
+)
+
+func TestStringFormatNormalize(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		leap    parse.LeapSecond
+		want    time.Time
+		n       parse.Normalization
+		wantErr bool
+	}{
+		{"end of day", "2023-04-22T24:00:00", parse.LeapSecondReject, time.Date(2023, 4, 23, 0, 0, 0, 0, time.UTC), parse.NormalizationEndOfDay, false},
+		{"end of day with zone", "2023-04-22T24:00:00+08:00", parse.LeapSecondReject, time.Date(2023, 4, 22, 16, 0, 0, 0, time.UTC), parse.NormalizationEndOfDay, false},
+		{"end of day fraction", "2023-04-22T24:00:00.000Z", parse.LeapSecondReject, time.Date(2023, 4, 23, 0, 0, 0, 0, time.UTC), parse.NormalizationEndOfDay, false},
+		{"end of day month end", "2023-12-31 24:00:00", parse.LeapSecondReject, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), parse.NormalizationEndOfDay, false},
+		{"end of day iso", "2023-04-22T24:00", parse.LeapSecondReject, time.Date(2023, 4, 23, 0, 0, 0, 0, time.UTC), parse.NormalizationEndOfDay, false},
+		{"past end of day", "2023-04-22T24:00:01", parse.LeapSecondReject, time.Time{}, parse.NormalizationNone, true},
+		{"leap second rejected", "2016-12-31T23:59:60Z", parse.LeapSecondReject, time.Time{}, parse.NormalizationNone, true},
+		{"leap second clamp", "2016-12-31T23:59:60Z", parse.LeapSecondClamp, time.Date(2016, 12, 31, 23, 59, 59, 999999999, time.UTC), parse.NormalizationLeapSecond, false},
+		{"leap second roll over", "2016-12-31T23:59:60Z", parse.LeapSecondRollOver, time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC), parse.NormalizationLeapSecond, false},
+		{"leap second fraction", "2016-12-31 23:59:60.5", parse.LeapSecondRollOver, time.Date(2017, 1, 1, 0, 0, 0, 500000000, time.UTC), parse.NormalizationLeapSecond, false},
+		{"leap second offset", "2017-01-01T08:59:60+09:00", parse.LeapSecondRollOver, time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC), parse.NormalizationLeapSecond, false},
+		{"regular time", "2023-04-22T18:22:15Z", parse.LeapSecondClamp, time.Date(2023, 4, 22, 18, 22, 15, 0, time.UTC), parse.NormalizationNone, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parse.FromStringFormat(tt.input, parse.WithFromStringLocation(time.UTC), parse.WithFromStringNormalize(tt.leap))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FromStringFormat(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if !errors.Is(err, parse.ErrOutOfRange) {
+					t.Errorf("FromStringFormat(%q) error = %v, want ErrOutOfRange", tt.input, err)
+				}
+				return
+			}
+			if !got.Time.Equal(tt.want) {
+				t.Errorf("FromStringFormat(%q) = %v, want %v", tt.input, got.Time, tt.want)
+			}
+			if got.Normalized != tt.n {
+				t.Errorf("FromStringFormat(%q) normalized = %v, want %v", tt.input, got.Normalized, tt.n)
+			}
+		})
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		if _, err := parse.FromStringFormat("2023-04-22T24:00:00", parse.WithFromStringLocation(time.UTC)); err == nil {
+			t.Error("FromStringFormat without normalization should reject 24:00:00")
+		}
+	})
+}
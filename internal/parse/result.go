@@ -0,0 +1,252 @@
+package parse
+
+import (
+	"strings"
+	"time"
+)
+
+// Kind 输入的类型
+type Kind int
+
+const (
+	KindUnknown         Kind = iota
+	KindTime                 // time.Time 或 *time.Time
+	KindTimestamp            // 数值时间戳
+	KindLayout               // 按格式匹配的字符串
+	KindKeyword              // 关键字，如 now、today
+	KindNaturalLanguage      // 自然语言表达式
+	KindTokens               // 按分词识别的字符串
+	KindISO8601              // 按 ISO 8601 语法解析的字符串
+	KindChinese              // 中文日期时间
+	KindExcelSerial          // 电子表格序列号
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindTime:
+		return "time"
+	case KindTimestamp:
+		return "timestamp"
+	case KindLayout:
+		return "layout"
+	case KindKeyword:
+		return "keyword"
+	case KindNaturalLanguage:
+		return "natural language"
+	case KindTokens:
+		return "tokens"
+	case KindISO8601:
+		return "ISO 8601"
+	case KindChinese:
+		return "chinese"
+	case KindExcelSerial:
+		return "excel serial"
+	default:
+		return "unknown"
+	}
+}
+
+// Unit 时间戳的单位
+type Unit int
+
+const (
+	UnitNone Unit = iota
+	UnitSecond
+	UnitMillisecond
+	UnitMicrosecond
+	UnitNanosecond
+)
+
+func (u Unit) String() string {
+	switch u {
+	case UnitSecond:
+		return "s"
+	case UnitMillisecond:
+		return "ms"
+	case UnitMicrosecond:
+		return "μs"
+	case UnitNanosecond:
+		return "ns"
+	default:
+		return "none"
+	}
+}
+
+// Precision 输入实际携带的时间精度
+type Precision int
+
+const (
+	PrecisionUnknown Precision = iota
+	PrecisionYear
+	PrecisionMonth
+	PrecisionDay
+	PrecisionHour
+	PrecisionMinute
+	PrecisionSecond
+	PrecisionMillisecond
+	PrecisionMicrosecond
+	PrecisionNanosecond
+)
+
+func (p Precision) String() string {
+	switch p {
+	case PrecisionYear:
+		return "year"
+	case PrecisionMonth:
+		return "month"
+	case PrecisionDay:
+		return "day"
+	case PrecisionHour:
+		return "hour"
+	case PrecisionMinute:
+		return "minute"
+	case PrecisionSecond:
+		return "second"
+	case PrecisionMillisecond:
+		return "millisecond"
+	case PrecisionMicrosecond:
+		return "microsecond"
+	case PrecisionNanosecond:
+		return "nanosecond"
+	default:
+		return "unknown"
+	}
+}
+
+// Normalization 解析时对输入做的规范化
+type Normalization int
+
+const (
+	NormalizationNone       Normalization = iota
+	NormalizationEndOfDay                 // 24:00:00 转换为次日零点
+	NormalizationLeapSecond               // 闰秒 23:59:60 按策略转换
+)
+
+func (n Normalization) String() string {
+	switch n {
+	case NormalizationEndOfDay:
+		return "end of day"
+	case NormalizationLeapSecond:
+		return "leap second"
+	default:
+		return "none"
+	}
+}
+
+// Result 解析结果及其元信息
+type Result struct {
+	time.Time
+
+	Layout    string    // 匹配的格式，仅 KindLayout 有效
+	Kind      Kind      // 输入类型
+	Unit      Unit      // 时间戳单位，仅 KindTimestamp 有效
+	Precision Precision // 输入携带的精度
+
+	Normalized Normalization // 对输入做的规范化，如 24:00:00 转换为次日零点
+}
+
+// unitPrecision 时间戳单位对应的精度
+func unitPrecision(u Unit) Precision {
+	switch u {
+	case UnitSecond:
+		return PrecisionSecond
+	case UnitMillisecond:
+		return PrecisionMillisecond
+	case UnitMicrosecond:
+		return PrecisionMicrosecond
+	case UnitNanosecond:
+		return PrecisionNanosecond
+	default:
+		return PrecisionUnknown
+	}
+}
+
+// fractionPrecision 小数位数对应的精度
+func fractionPrecision(digits int) Precision {
+	switch {
+	case digits <= 0:
+		return PrecisionSecond
+	case digits <= 3:
+		return PrecisionMillisecond
+	case digits <= 6:
+		return PrecisionMicrosecond
+	default:
+		return PrecisionNanosecond
+	}
+}
+
+// layoutPrecision 根据匹配的格式及原始输入推断精度
+// time.Parse 在秒之后总会接受小数部分，因此小数位数以输入为准
+func layoutPrecision(layout, s string) Precision {
+	precision := PrecisionYear
+	for rest := layout; rest != ""; {
+		elem, p := nextLayoutElement(rest)
+		if p > precision {
+			precision = p
+		}
+		rest = rest[len(elem):]
+	}
+	if precision == PrecisionSecond {
+		return fractionPrecision(secondFractionDigits(s))
+	}
+	return precision
+}
+
+// layoutElements 格式中的元素及其精度，较长的在前，按完整元素匹配，如 "2006" 中的 "2" 不视为日
+var layoutElements = []struct {
+	elem      string
+	precision Precision
+}{
+	{"January", PrecisionMonth}, {"Monday", PrecisionDay},
+	{"Z07:00:00", PrecisionUnknown}, {"-07:00:00", PrecisionUnknown},
+	{"Z070000", PrecisionUnknown}, {"-070000", PrecisionUnknown},
+	{"Z07:00", PrecisionUnknown}, {"-07:00", PrecisionUnknown},
+	{"Z0700", PrecisionUnknown}, {"-0700", PrecisionUnknown},
+	{"_2006", PrecisionYear}, {"2006", PrecisionYear},
+	{"Z07", PrecisionUnknown}, {"-07", PrecisionUnknown},
+	{"Jan", PrecisionMonth}, {"Mon", PrecisionDay}, {"MST", PrecisionUnknown},
+	{"002", PrecisionDay}, {"__2", PrecisionDay},
+	{"01", PrecisionMonth}, {"02", PrecisionDay}, {"_2", PrecisionDay}, {"03", PrecisionHour},
+	{"04", PrecisionMinute}, {"05", PrecisionSecond}, {"06", PrecisionYear}, {"15", PrecisionHour},
+	{"1", PrecisionMonth}, {"2", PrecisionDay}, {"3", PrecisionHour}, {"4", PrecisionMinute}, {"5", PrecisionSecond},
+}
+
+// nextLayoutElement 格式开头的元素及其精度，不是元素时返回第一个字节及 PrecisionUnknown
+func nextLayoutElement(layout string) (string, Precision) {
+	for _, e := range layoutElements {
+		if strings.HasPrefix(layout, e.elem) {
+			return e.elem, e.precision
+		}
+	}
+	return layout[:1], PrecisionUnknown
+}
+
+// secondFractionDigits 返回 hh:mm:ss 之后小数部分的位数
+func secondFractionDigits(s string) int {
+	for i := 0; i+8 < len(s); i++ {
+		if s[i+2] != ':' || s[i+5] != ':' || !isDigits(s[i:i+2]) || !isDigits(s[i+3:i+5]) || !isDigits(s[i+6:i+8]) {
+			continue
+		}
+		if s[i+8] != '.' && s[i+8] != ',' {
+			return 0
+		}
+		n := 0
+		for j := i + 9; j < len(s) && s[j] >= '0' && s[j] <= '9'; j++ {
+			n++
+		}
+		return n
+	}
+	return 0
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
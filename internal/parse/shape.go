@@ -0,0 +1,203 @@
+package parse
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// shape 字符串开头的形态：首字符类别，以及开头数字段之后的第一个字符
+// 用于在调用 time.Parse 之前排除必然无法匹配的格式，不会排除任何可能匹配的格式
+type shape struct {
+	lead byte // 'd' 数字，'a' 字母，'o' 其他；格式中为 0 表示不确定
+	sep  byte // 开头数字段之后的字符，0 表示不确定
+}
+
+// 格式开头可能出现的数字元素，较长的在前
+var leadingNumericElements = []string{"2006", "002", "01", "02", "03", "04", "05", "06", "15", "1", "2", "3", "4", "5"}
+
+// layoutShape 计算格式开头的形态
+func layoutShape(layout string) shape {
+	if layout == "" {
+		return shape{}
+	}
+
+	c := layout[0]
+	switch {
+	case isLetter(c):
+		// MST 与 Z07:00 可以匹配以 + - 开头的时区偏移
+		if strings.HasPrefix(layout, "MST") || strings.HasPrefix(layout, "Z07") {
+			return shape{}
+		}
+		return shape{lead: 'a'}
+	case c >= '0' && c <= '9':
+		for _, elem := range leadingNumericElements {
+			if !strings.HasPrefix(layout, elem) {
+				continue
+			}
+			rest := layout[len(elem):]
+			if rest == "" {
+				return shape{lead: 'd'}
+			}
+			switch sep := rest[0]; sep {
+			case ' ', '/', ':', 'T':
+				return shape{lead: 'd', sep: sep}
+			case '-':
+				if !strings.HasPrefix(rest, "-07") {
+					return shape{lead: 'd', sep: sep}
+				}
+			case '.', ',':
+				if len(rest) > 1 && rest[1] != '0' && rest[1] != '9' {
+					return shape{lead: 'd', sep: sep}
+				}
+			}
+			return shape{lead: 'd'}
+		}
+		return shape{lead: 'd'}
+	default:
+		return shape{}
+	}
+}
+
+// inputShape 计算输入开头的形态
+func inputShape(s string) shape {
+	if s == "" {
+		return shape{lead: 'o'}
+	}
+
+	c := s[0]
+	switch {
+	case isLetter(c):
+		return shape{lead: 'a'}
+	case c >= '0' && c <= '9':
+		for i := 1; i < len(s); i++ {
+			if s[i] < '0' || s[i] > '9' {
+				return shape{lead: 'd', sep: s[i]}
+			}
+		}
+		return shape{lead: 'd'}
+	default:
+		return shape{lead: 'o'}
+	}
+}
+
+// accepts 判断输入是否可能匹配该格式
+func (l shape) accepts(in shape) bool {
+	if l.lead != 0 && l.lead != in.lead {
+		return false
+	}
+	if l.sep != 0 && in.sep != 0 && l.sep != in.sep {
+		return false
+	}
+	return true
+}
+
+func isLetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// layoutIndex 按输入形态预先筛选出的格式列表，各列表保持原有的尝试顺序
+// 创建后只读，解析时按输入形态直接取出候选格式，不再逐个判断
+type layoutIndex struct {
+	byShape map[shape][]compiledLayout
+	other   []compiledLayout // 以数字开头、数字段之后的字符未出现在任何格式中的输入
+}
+
+// newLayoutIndex 按输入可能出现的形态建立索引
+func newLayoutIndex(layouts []compiledLayout) *layoutIndex {
+	keys := []shape{{lead: 'a'}, {lead: 'o'}, {lead: 'd'}}
+	for _, l := range layouts {
+		if l.shape.sep != 0 && (l.shape.lead == 0 || l.shape.lead == 'd') {
+			keys = append(keys, shape{lead: 'd', sep: l.shape.sep})
+		}
+	}
+
+	idx := &layoutIndex{byShape: make(map[shape][]compiledLayout, len(keys))}
+	for _, in := range keys {
+		if _, ok := idx.byShape[in]; ok {
+			continue
+		}
+		idx.byShape[in] = filterLayouts(layouts, func(l shape) bool { return l.accepts(in) })
+	}
+	idx.other = filterLayouts(layouts, func(l shape) bool {
+		return (l.lead == 0 || l.lead == 'd') && l.sep == 0
+	})
+	return idx
+}
+
+// lookup 与输入形态相符、需要尝试的格式
+func (idx *layoutIndex) lookup(in shape) []compiledLayout {
+	if layouts, ok := idx.byShape[in]; ok {
+		return layouts
+	}
+	return idx.other
+}
+
+func filterLayouts(layouts []compiledLayout, keep func(shape) bool) []compiledLayout {
+	var filtered []compiledLayout
+	for _, l := range layouts {
+		if keep(l.shape) {
+			filtered = append(filtered, l)
+		}
+	}
+	return filtered
+}
+
+// skeletonSamples 生成格式样例的参考时间，日、月、时均为两位数，含及不含小数秒、时区偏移
+var skeletonSamples = []time.Time{
+	time.Date(2023, 12, 25, 23, 45, 56, 789123456, time.FixedZone("CST", 8*3600)),
+	time.Date(2023, 12, 25, 23, 45, 56, 0, time.FixedZone("CST", 8*3600)),
+	time.Date(2023, 12, 25, 23, 45, 56, 789123456, time.UTC),
+	time.Date(2023, 12, 25, 23, 45, 56, 0, time.UTC),
+}
+
+// layoutSkeletons 缓存各格式样例的 skeleton，格式来自内置列表及调用方的选项，数量有限
+var layoutSkeletons sync.Map
+
+// layoutMatches 判断输入与格式的结构是否一致：按格式生成的样例与输入的 skeleton 相同
+func layoutMatches(layout, s string) bool {
+	skeletons, ok := layoutSkeletons.Load(layout)
+	if !ok {
+		samples := make([]string, 0, len(skeletonSamples))
+		for _, sample := range skeletonSamples {
+			samples = append(samples, skeleton(sample.Format(layout)))
+		}
+		skeletons, _ = layoutSkeletons.LoadOrStore(strings.Clone(layout), samples)
+	}
+
+	in := skeleton(s)
+	for _, sk := range skeletons.([]string) {
+		if sk == in {
+			return true
+		}
+	}
+	return false
+}
+
+// skeleton 字符串的结构：连续数字记为 '0'，连续字母记为 'a'，连续空白记为一个空格，正负号统一为 '+'，其他字符保留
+func skeleton(s string) string {
+	var b strings.Builder
+	var last byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c >= '0' && c <= '9':
+			c = '0'
+		case isLetter(c):
+			c = 'a'
+		case c == ' ' || c == '\t':
+			c = ' '
+		case c == '-' || c == '+':
+			c = '+'
+		default:
+			b.WriteByte(c)
+			last = 0
+			continue
+		}
+		if c != last || c == '+' {
+			b.WriteByte(c)
+		}
+		last = c
+	}
+	return b.String()
+}
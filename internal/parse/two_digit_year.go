@@ -0,0 +1,74 @@
+package parse
+
+import "time"
+
+// defaultTwoDigitYearPivot 默认的窗口起始年份，与 time.Parse 一致：69~99 为 19xx，00~68 为 20xx
+const defaultTwoDigitYearPivot = 1969
+
+// TwoDigitYear 两位年份的转换规则：两位年份落在以起始年份开始的 100 年窗口内
+// 零值与 time.Parse 一致，窗口为 1969~2068
+type TwoDigitYear struct {
+	pivot   int  // 窗口的起始年份
+	sliding bool // 窗口随基准时间滑动
+	ahead   int  // 滑动窗口中晚于基准年份的年数
+}
+
+// TwoDigitYearPivot 固定窗口，窗口为 pivot~pivot+99，如 pivot 为 1930 时，"29" 为 2029，"30" 为 1930
+func TwoDigitYearPivot(pivot int) TwoDigitYear {
+	return TwoDigitYear{pivot: pivot}
+}
+
+// TwoDigitYearSlidingWindow 滑动窗口，窗口截止到基准年份之后 ahead 年，如 ahead 为 0 时两位年份不会晚于基准年份
+func TwoDigitYearSlidingWindow(ahead int) TwoDigitYear {
+	return TwoDigitYear{sliding: true, ahead: ahead}
+}
+
+// IsZero 是否为默认规则
+func (r TwoDigitYear) IsZero() bool {
+	return r == TwoDigitYear{}
+}
+
+// Resolve 将两位年份（0~99）转换为完整年份
+func (r TwoDigitYear) Resolve(yy int, base time.Time) int {
+	start := defaultTwoDigitYearPivot
+	switch {
+	case r.sliding:
+		start = base.Year() + r.ahead - 99
+	case r.pivot != 0:
+		start = r.pivot
+	}
+
+	century := start - (start%100+100)%100
+	year := century + yy
+	if year < start {
+		year += 100
+	}
+	return year
+}
+
+// hasTwoDigitYear 格式中含有两位年份 "06"，按 time 包识别格式元素的方式扫描，"2006" 与 "002" 为其他元素
+func hasTwoDigitYear(layout string) bool {
+	for i := 0; i < len(layout); i++ {
+		switch {
+		case len(layout) >= i+4 && layout[i:i+4] == "2006":
+			i += 3
+		case len(layout) >= i+3 && layout[i:i+3] == "002":
+			i += 2
+		case len(layout) >= i+2 && layout[i:i+2] == "06":
+			return true
+		}
+	}
+	return false
+}
+
+// resolveTwoDigitYear 按指定规则重新确定 time.Parse 结果中两位年份对应的完整年份，2 月 29 日落在平年时返回错误
+func (f *StringFormat) resolveTwoDigitYear(t time.Time, s, layout string) (time.Time, *ParseError) {
+	year := f.twoDigit.Resolve(t.Year()%100, f.base().In(t.Location()))
+	if year == t.Year() {
+		return t, nil
+	}
+	if component := outOfRange(year, t.Month(), t.Day(), 0, 0, 0); component != "" {
+		return time.Time{}, newError(KindLayout, component, ErrOutOfRange, "%s as %q in %d", s, layout, year)
+	}
+	return time.Date(year, t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location()), nil
+}
@@ -0,0 +1,89 @@
+package parse_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gomooth/chronos/internal/parse"
+)
+
+func TestTwoDigitYearResolve(t *testing.T) {
+	base := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		rule parse.TwoDigitYear
+		yy   int
+		want int
+	}{
+		{"default 68", parse.TwoDigitYear{}, 68, 2068},
+		{"default 69", parse.TwoDigitYear{}, 69, 1969},
+		{"pivot 1930 low", parse.TwoDigitYearPivot(1930), 29, 2029},
+		{"pivot 1930 high", parse.TwoDigitYearPivot(1930), 30, 1930},
+		{"pivot 1900", parse.TwoDigitYearPivot(1900), 99, 1999},
+		{"pivot 1800", parse.TwoDigitYearPivot(1800), 5, 1805},
+		{"sliding past", parse.TwoDigitYearSlidingWindow(0), 25, 1925},
+		{"sliding base year", parse.TwoDigitYearSlidingWindow(0), 24, 2024},
+		{"sliding ahead", parse.TwoDigitYearSlidingWindow(20), 44, 2044},
+		{"sliding ahead past", parse.TwoDigitYearSlidingWindow(20), 45, 1945},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.rule.Resolve(tt.yy, base); got != tt.want {
+				t.Errorf("Resolve(%d) = %d, want %d", tt.yy, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTwoDigitYearLayouts(t *testing.T) {
+	base := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	pivot := parse.TwoDigitYearPivot(1900)
+
+	tests := []struct {
+		name    string
+		input   string
+		opts    []func(*parse.FromStringOption)
+		want    time.Time
+		wantErr error
+	}{
+		{"rfc822 default", "02 Jan 45 15:04 UTC", nil, time.Date(2045, 1, 2, 15, 4, 0, 0, time.UTC), nil},
+		{"rfc822 pivot", "02 Jan 45 15:04 UTC", []func(*parse.FromStringOption){parse.WithFromStringTwoDigitYear(pivot)}, time.Date(1945, 1, 2, 15, 4, 0, 0, time.UTC), nil},
+		{"rfc850 sliding", "Tuesday, 02-Jan-30 15:04:05 UTC", []func(*parse.FromStringOption){parse.WithFromStringTwoDigitYear(parse.TwoDigitYearSlidingWindow(0))}, time.Date(1930, 1, 2, 15, 4, 5, 0, time.UTC), nil},
+		{"custom layout", "01.02.50", []func(*parse.FromStringOption){parse.WithFromStringLayout("02.01.06"), parse.WithFromStringTwoDigitYear(pivot)}, time.Date(1950, 2, 1, 0, 0, 0, 0, time.UTC), nil},
+		{"four digit year unchanged", "2045-01-02", []func(*parse.FromStringOption){parse.WithFromStringTwoDigitYear(pivot)}, time.Date(2045, 1, 2, 0, 0, 0, 0, time.UTC), nil},
+		{"leap day in common year", "29.02.00", []func(*parse.FromStringOption){parse.WithFromStringLayout("02.01.06"), parse.WithFromStringTwoDigitYear(pivot)}, time.Time{}, parse.ErrOutOfRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := append([]func(*parse.FromStringOption){parse.WithFromStringLocation(time.UTC), parse.WithFromStringBaseTime(base)}, tt.opts...)
+			got, err := parse.FromStringFormat(tt.input, opts...)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("FromStringFormat(%q) error = %v, want %v", tt.input, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("FromStringFormat(%q) error = %v", tt.input, err)
+			}
+			if !got.Time.Equal(tt.want) {
+				t.Errorf("FromStringFormat(%q) = %v, want %v", tt.input, got.Time, tt.want)
+			}
+		})
+	}
+
+	t.Run("tokens and chinese", func(t *testing.T) {
+		tokens, err := parse.FromTokens("22 Apr 45", parse.WithFromTokensLocation(time.UTC), parse.WithFromTokensTwoDigitYear(pivot))
+		if err != nil || tokens.Year() != 1945 {
+			t.Errorf("FromTokens = %v, %v, want 1945", tokens.Time, err)
+		}
+		chinese, err := parse.FromChinese("45年4月22日", parse.WithFromChineseLocation(time.UTC), parse.WithFromChineseTwoDigitYear(pivot))
+		if err != nil || chinese.Year() != 1945 {
+			t.Errorf("FromChinese = %v, %v, want 1945", chinese.Time, err)
+		}
+	})
+}
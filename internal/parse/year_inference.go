@@ -0,0 +1,45 @@
+package parse
+
+import (
+	"time"
+
+	"github.com/gomooth/chronos/internal/helper"
+)
+
+// yearlessMaxAhead 推断年份时允许晚于基准时间的最大时长，容忍时钟偏差及时区差异
+const yearlessMaxAhead = 7 * 24 * time.Hour
+
+// isYearless 格式中有月、日但没有年份，如 time.Stamp、"01/02 15:04"
+// 2001 年与 2007 年的日历完全相同，两者格式化结果一致说明格式中没有年份；
+// 2001 年 1 月 1 日与 10 月 1 日均为周一，两者格式化结果不同说明格式中有月、日
+func isYearless(layout string) bool {
+	ref := time.Date(2001, time.January, 1, 0, 0, 0, 0, time.UTC)
+	s := ref.Format(layout)
+	return s == ref.AddDate(6, 0, 0).Format(layout) && s != ref.AddDate(0, 9, 0).Format(layout)
+}
+
+// inferYear 为不含年份的时间确定年份：取与基准时间最接近、且晚于基准时间不超过 yearlessMaxAhead 的年份
+// 如基准时间为 1 月 5 日时，"Dec 31" 推断为上一年；2 月 29 日取最近的闰年
+func (f *StringFormat) inferYear(t time.Time) time.Time {
+	base := f.base().In(t.Location())
+
+	var best time.Time
+	var bestDiff time.Duration
+	for year := base.Year() + 1; year >= base.Year()-8; year-- {
+		if t.Day() > helper.DaysInMonth(year, t.Month()) {
+			continue
+		}
+		c := time.Date(year, t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+		diff := c.Sub(base)
+		if diff > yearlessMaxAhead {
+			continue
+		}
+		if diff < 0 {
+			diff = -diff
+		}
+		if best.IsZero() || diff < bestDiff {
+			best, bestDiff = c, diff
+		}
+	}
+	return best
+}
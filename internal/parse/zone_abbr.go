@@ -0,0 +1,108 @@
+package parse
+
+import (
+	"strings"
+	"time"
+)
+
+// zoneCandidate 时区缩写的一种解读
+type zoneCandidate struct {
+	region string // 使用该缩写的地区
+	offset int    // 与 UTC 的偏移秒数
+}
+
+// zoneAbbreviations 常见时区缩写，缩写本身区分标准时间与夏令时，因此对应固定偏移
+// 存在多种解读的缩写，第一项为默认解读
+var zoneAbbreviations = map[string][]zoneCandidate{
+	"UT": {{"UTC", 0}}, "UTC": {{"UTC", 0}}, "GMT": {{"UTC", 0}}, "Z": {{"UTC", 0}},
+
+	// 北美
+	"EST": {{"US Eastern", -5 * 3600}}, "EDT": {{"US Eastern", -4 * 3600}},
+	"CST": {{"US Central", -6 * 3600}, {"China", 8 * 3600}, {"Cuba", -5 * 3600}},
+	"CDT": {{"US Central", -5 * 3600}, {"Cuba", -4 * 3600}},
+	"MST": {{"US Mountain", -7 * 3600}}, "MDT": {{"US Mountain", -6 * 3600}},
+	"PST": {{"US Pacific", -8 * 3600}}, "PDT": {{"US Pacific", -7 * 3600}},
+	"AKST": {{"Alaska", -9 * 3600}}, "AKDT": {{"Alaska", -8 * 3600}},
+	"HST": {{"Hawaii", -10 * 3600}},
+	"AST": {{"Atlantic", -4 * 3600}, {"Arabia", 3 * 3600}}, "ADT": {{"Atlantic", -3 * 3600}},
+	"NST": {{"Newfoundland", -(3*3600 + 1800)}}, "NDT": {{"Newfoundland", -(2*3600 + 1800)}},
+
+	// 南美
+	"BRT": {{"Brasilia", -3 * 3600}}, "ART": {{"Argentina", -3 * 3600}},
+
+	// 欧洲
+	"WET": {{"Western Europe", 0}}, "WEST": {{"Western Europe", 1 * 3600}},
+	"CET": {{"Central Europe", 1 * 3600}}, "CEST": {{"Central Europe", 2 * 3600}},
+	"EET": {{"Eastern Europe", 2 * 3600}}, "EEST": {{"Eastern Europe", 3 * 3600}},
+	"BST": {{"British", 1 * 3600}, {"Bangladesh", 6 * 3600}},
+	"IST": {{"India", 5*3600 + 1800}, {"Ireland", 1 * 3600}, {"Israel", 2 * 3600}},
+	"MSK": {{"Moscow", 3 * 3600}},
+
+	// 亚洲
+	"IDT": {{"Israel", 3 * 3600}},
+	"PKT": {{"Pakistan", 5 * 3600}}, "NPT": {{"Nepal", 5*3600 + 2700}},
+	"ICT": {{"Indochina", 7 * 3600}}, "WIB": {{"Western Indonesia", 7 * 3600}},
+	"HKT": {{"Hong Kong", 8 * 3600}}, "SGT": {{"Singapore", 8 * 3600}}, "PHT": {{"Philippines", 8 * 3600}},
+	"JST": {{"Japan", 9 * 3600}}, "KST": {{"Korea", 9 * 3600}},
+
+	// 大洋洲
+	"AWST": {{"Western Australia", 8 * 3600}},
+	"ACST": {{"Central Australia", 9*3600 + 1800}}, "ACDT": {{"Central Australia", 10*3600 + 1800}},
+	"AEST": {{"Eastern Australia", 10 * 3600}}, "AEDT": {{"Eastern Australia", 11 * 3600}},
+	"NZST": {{"New Zealand", 12 * 3600}}, "NZDT": {{"New Zealand", 13 * 3600}},
+}
+
+// zoneAbbrRule 时区缩写的解读规则，按格式及按分词解析时共用
+type zoneAbbrRule struct {
+	zones  map[string]*time.Location // 时区缩写偏好
+	loc    *time.Location            // 目标时区
+	strict bool
+	kind   Kind
+}
+
+// resolveZoneAbbr 修正按含 MST 格式解析得到的时区
+func (f *StringFormat) resolveZoneAbbr(t time.Time) (time.Time, error) {
+	return zoneAbbrRule{zones: f.zones, loc: f.loc, strict: f.strict, kind: KindLayout}.resolve(t)
+}
+
+// resolve 修正按缩写得到的时区
+// time.Parse 遇到与目标时区不符的缩写时会生成偏移为 0 的时区，这里按以下顺序确定实际偏移：
+// 指定的缩写偏好、目标时区本身的缩写、缩写表；无法识别的缩写返回错误
+func (r zoneAbbrRule) resolve(t time.Time) (time.Time, error) {
+	abbr, _ := t.Zone()
+	if loc, ok := r.zones[strings.ToUpper(abbr)]; ok {
+		return wallClockIn(t, loc), nil
+	}
+	// 缩写与目标时区一致，或为 UTC、GMT±h 等 time.Parse 能正确处理的形式
+	if t.Location() == r.loc || t.Location() == time.UTC || strings.HasPrefix(abbr, "GMT") {
+		return t, nil
+	}
+
+	candidates, ok := zoneAbbreviations[strings.ToUpper(abbr)]
+	if !ok {
+		return time.Time{}, newError(r.kind, "zone", ErrUnsupportedExpression, "unknown time zone abbreviation: %s", abbr)
+	}
+	if r.strict && len(candidates) > 1 {
+		regions := make([]string, 0, len(candidates))
+		for _, c := range candidates {
+			regions = append(regions, c.region)
+		}
+		return time.Time{}, newError(r.kind, "zone", ErrAmbiguous, "time zone %s can be %s", abbr, strings.Join(regions, ", "))
+	}
+	return wallClockIn(t, time.FixedZone(abbr, candidates[0].offset)), nil
+}
+
+// abbrLocation 按 time.Parse 的规则确定缩写对应的时区：UTC、目标时区本身的缩写得到对应时区，其他缩写得到偏移为 0 的时区，
+// 之后再由 zoneAbbrRule.resolve 修正
+func abbrLocation(abbr string, loc *time.Location) (*time.Location, bool) {
+	t, err := time.ParseInLocation("MST", strings.ToUpper(abbr), loc)
+	if err != nil {
+		return nil, false
+	}
+	return t.Location(), true
+}
+
+// wallClockIn 保持墙上时间不变，更换时区
+func wallClockIn(t time.Time, loc *time.Location) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc)
+}
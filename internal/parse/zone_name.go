@@ -0,0 +1,166 @@
+package parse
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ZoneConflict 时区名与数字偏移不一致时的处理方式
+type ZoneConflict int
+
+const (
+	ZoneConflictReject    ZoneConflict = iota // 返回错误
+	ZoneConflictUseOffset                     // 以偏移为准，保持时刻不变，转换到时区名对应的时区
+	ZoneConflictUseZone                       // 以时区名为准，保持墙上时间不变，忽略偏移
+)
+
+func (c ZoneConflict) String() string {
+	switch c {
+	case ZoneConflictReject:
+		return "reject"
+	case ZoneConflictUseOffset:
+		return "use offset"
+	case ZoneConflictUseZone:
+		return "use zone"
+	default:
+		return "unknown"
+	}
+}
+
+// zoneCache time.LoadLocation 每次都会读取时区数据库，缓存已加载的时区
+var zoneCache sync.Map
+
+// loadZone 加载 IANA 时区或 RFC 9557 方括号中的偏移，如 "Asia/Shanghai"、"+08:00"
+func loadZone(name string) (*time.Location, error) {
+	if loc, ok := zoneCache.Load(name); ok {
+		return loc.(*time.Location), nil
+	}
+
+	// 输入可能引用调用方的字节切片，缓存前复制
+	name = strings.Clone(name)
+	var loc *time.Location
+	if name[0] == '+' || name[0] == '-' {
+		sc := &isoScanner{s: name}
+		offset, _, err := sc.isoZone()
+		if err != nil || !sc.done() {
+			return nil, newError(KindLayout, "zone", ErrUnsupportedExpression, "invalid time zone offset: %s", name)
+		}
+		loc = time.FixedZone(name, offset)
+	} else {
+		var err error
+		if loc, err = time.LoadLocation(name); err != nil {
+			return nil, newError(KindLayout, "zone", ErrUnsupportedExpression, "unknown time zone %q: %w", name, err)
+		}
+	}
+	zoneCache.Store(name, loc)
+	return loc, nil
+}
+
+// splitZoneSuffix 拆分输入末尾的时区名
+// 支持 RFC 9557 方括号形式，如 "2023-04-22T18:22:15+08:00[Asia/Shanghai]"，其后的 [u-ca=iso8601] 等扩展标记被忽略；
+// 以及以空格分隔、含有 / 的 IANA 时区名，如 "2023-04-22 18:22:15 Asia/Shanghai"
+// 不含 / 的时区名及时区缩写在完整输入无法解析时由 parseZoneWordSuffix 处理
+// 没有时区名时 name 为空
+func splitZoneSuffix(s string) (rest, name string) {
+	if i := strings.IndexByte(s, '['); i > 0 && strings.HasSuffix(s, "]") {
+		for _, tag := range strings.Split(s[i+1:len(s)-1], "][") {
+			tag = strings.TrimPrefix(tag, "!")
+			if tag != "" && !strings.Contains(tag, "=") && name == "" {
+				name = tag
+			}
+		}
+		return s[:i], name
+	}
+
+	rest, last, ok := splitLastWord(s)
+	if !ok || !strings.Contains(last, "/") {
+		return s, ""
+	}
+	return rest, last
+}
+
+// splitLastWord 拆分以空格分隔、大写字母开头的最后一个单词
+func splitLastWord(s string) (rest, last string, ok bool) {
+	i := strings.LastIndexByte(s, ' ')
+	if i < 0 || i == len(s)-1 || s[i+1] < 'A' || s[i+1] > 'Z' {
+		return s, "", false
+	}
+	return strings.TrimRight(s[:i], " "), s[i+1:], true
+}
+
+// parseZoneWordSuffix 完整输入无法解析时，将末尾不含 / 的单词视为时区
+// 时区缩写（如 "2023-04-22 18:22:15 UTC"、"... PST"）优先，时区与按含 MST 格式解析时一致：依次取缩写偏好、目标时区本身的缩写、缩写表；
+// 其他单词能加载为 IANA 时区时（如 "Japan"、"UCT"）按该时区解析其余部分
+// 不能按时区处理，或其余部分无法解析时返回 false
+func (f *StringFormat) parseZoneWordSuffix(s string) (Result, bool, error) {
+	rest, word, ok := splitLastWord(s)
+	if !ok || strings.Contains(word, "/") {
+		return Result{}, false, nil
+	}
+
+	if _, ok := zoneAbbreviations[strings.ToUpper(word)]; ok {
+		res, err := f.parse(rest)
+		if err != nil || res.Kind != KindLayout || res.Location() != f.loc {
+			return Result{}, false, nil
+		}
+		zone, ok := abbrLocation(word, f.loc)
+		if !ok {
+			return Result{}, false, nil
+		}
+		if res.Time, err = f.resolveZoneAbbr(wallClockIn(res.Time, zone)); err != nil {
+			return Result{}, true, err
+		}
+		res.Layout += " MST"
+		return res, true, nil
+	}
+
+	if _, err := loadZone(word); err != nil {
+		return Result{}, false, nil
+	}
+	res, err := f.parseInZone(rest, word)
+	if err != nil && !errors.Is(err, ErrAmbiguous) {
+		return Result{}, false, nil
+	}
+	return res, true, err
+}
+
+// parseInZone 按指定时区解析其余部分，输入同时带有数字偏移时检查两者是否一致
+func (f *StringFormat) parseInZone(s, name string) (Result, error) {
+	loc, err := loadZone(name)
+	if err != nil {
+		return Result{}, err
+	}
+
+	inZone := *f
+	inZone.loc = loc
+	res, err := inZone.parse(s)
+	if err != nil {
+		return Result{}, err
+	}
+	if res.Location() == loc {
+		return res, nil
+	}
+	// RFC 9557 中 Z（及 UTC）表示时刻已知、当地偏移未知，不视为冲突，直接转换到指定时区
+	if res.Location() == time.UTC {
+		res.Time = res.In(loc)
+		return res, nil
+	}
+
+	// 输入带有偏移
+	_, offset := res.Zone()
+	if _, zoneOffset := res.In(loc).Zone(); zoneOffset == offset {
+		res.Time = res.In(loc)
+		return res, nil
+	}
+	switch f.conflict {
+	case ZoneConflictUseOffset:
+		res.Time = res.In(loc)
+	case ZoneConflictUseZone:
+		res.Time = wallClockIn(res.Time, loc)
+	default:
+		return Result{}, newError(res.Kind, "zone", ErrAmbiguous, "offset %s conflicts with time zone %s: %s", res.Format("-07:00"), name, s)
+	}
+	return res, nil
+}
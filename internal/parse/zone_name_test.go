@@ -0,0 +1,100 @@
+package parse_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gomooth/chronos/internal/parse"
+)
+
+func TestStringFormatZoneName(t *testing.T) {
+	shanghai, err := time.LoadLocation("Asia/Shanghai")
+	if err != nil {
+		t.Skip("time zone database not available")
+	}
+	newYork, _ := time.LoadLocation("America/New_York")
+	tokyo, _ := time.LoadLocation("Japan")
+
+	tests := []struct {
+		name     string
+		input    string
+		conflict parse.ZoneConflict
+		expected time.Time
+		loc      *time.Location
+		wantErr  bool
+	}{
+		{"trailing name", "2023-04-22 18:22:15 Asia/Shanghai", parse.ZoneConflictReject, time.Date(2023, 4, 22, 18, 22, 15, 0, shanghai), shanghai, false},
+		{"trailing name date only", "2023-04-22 America/New_York", parse.ZoneConflictReject, time.Date(2023, 4, 22, 0, 0, 0, 0, newYork), newYork, false},
+		{"bracket with offset", "2023-04-22T18:22:15+08:00[Asia/Shanghai]", parse.ZoneConflictReject, time.Date(2023, 4, 22, 18, 22, 15, 0, shanghai), shanghai, false},
+		{"bracket without offset", "2023-04-22T18:22:15[Asia/Shanghai]", parse.ZoneConflictReject, time.Date(2023, 4, 22, 18, 22, 15, 0, shanghai), shanghai, false},
+		{"bracket dst", "2023-07-01T12:00:00-04:00[America/New_York]", parse.ZoneConflictReject, time.Date(2023, 7, 1, 12, 0, 0, 0, newYork), newYork, false},
+		{"bracket with fraction and tags", "2023-04-22T18:22:15.123+08:00[Asia/Shanghai][u-ca=iso8601]", parse.ZoneConflictReject, time.Date(2023, 4, 22, 18, 22, 15, 123000000, shanghai), shanghai, false},
+		{"critical bracket", "2023-04-22T18:22:15+08:00[!Asia/Shanghai]", parse.ZoneConflictReject, time.Date(2023, 4, 22, 18, 22, 15, 0, shanghai), shanghai, false},
+		{"bracket utc", "2023-04-22T18:22:15Z[UTC]", parse.ZoneConflictReject, time.Date(2023, 4, 22, 18, 22, 15, 0, time.UTC), time.UTC, false},
+		{"bracket after z", "2023-04-22T10:22:15Z[Asia/Shanghai]", parse.ZoneConflictReject, time.Date(2023, 4, 22, 18, 22, 15, 0, shanghai), shanghai, false},
+		{"trailing name after z", "2023-04-22T10:22:15Z Asia/Shanghai", parse.ZoneConflictReject, time.Date(2023, 4, 22, 18, 22, 15, 0, shanghai), shanghai, false},
+		{"bracket offset", "2023-04-22T18:22:15+08:00[+08:00]", parse.ZoneConflictReject, time.Date(2023, 4, 22, 10, 22, 15, 0, time.UTC), nil, false},
+		{"trailing utc", "2023-04-22 18:22:15 UTC", parse.ZoneConflictReject, time.Date(2023, 4, 22, 18, 22, 15, 0, time.UTC), time.UTC, false},
+		{"trailing etc alias", "2023-04-22 18:22:15 Etc/GMT-8", parse.ZoneConflictReject, time.Date(2023, 4, 22, 10, 22, 15, 0, time.UTC), nil, false},
+		{"trailing name without slash", "2023-04-22 18:22:15 Japan", parse.ZoneConflictReject, time.Date(2023, 4, 22, 18, 22, 15, 0, tokyo), tokyo, false},
+		{"trailing abbreviation", "2023-04-22 18:22:15 PST", parse.ZoneConflictReject, time.Date(2023, 4, 23, 2, 22, 15, 0, time.UTC), nil, false},
+		{"tags only", "2023-04-22T18:22:15Z[u-ca=iso8601]", parse.ZoneConflictReject, time.Date(2023, 4, 22, 18, 22, 15, 0, time.UTC), nil, false},
+
+		// 偏移与时区名不一致
+		{"conflict", "2023-04-22T18:22:15+09:00[Asia/Shanghai]", parse.ZoneConflictReject, time.Time{}, nil, true},
+		{"conflict zero offset", "2023-04-22T18:22:15+00:00[Asia/Shanghai]", parse.ZoneConflictReject, time.Time{}, nil, true},
+		{"conflict use offset", "2023-04-22T18:22:15+09:00[Asia/Shanghai]", parse.ZoneConflictUseOffset, time.Date(2023, 4, 22, 17, 22, 15, 0, shanghai), shanghai, false},
+		{"conflict use zone", "2023-04-22T18:22:15+09:00[Asia/Shanghai]", parse.ZoneConflictUseZone, time.Date(2023, 4, 22, 18, 22, 15, 0, shanghai), shanghai, false},
+
+		// 异常情况
+		{"unknown zone", "2023-04-22T18:22:15[Mars/Olympus]", parse.ZoneConflictReject, time.Time{}, nil, true},
+		{"unknown trailing zone", "2023-04-22 18:22:15 Mars/Olympus", parse.ZoneConflictReject, time.Time{}, nil, true},
+		{"unknown trailing word", "2023-04-22 18:22:15 Olympus", parse.ZoneConflictReject, time.Time{}, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parse.FromStringFormat(tt.input, parse.WithFromStringLocation(time.UTC), parse.WithFromStringZoneConflict(tt.conflict))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FromStringFormat(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !got.Equal(tt.expected) {
+				t.Errorf("FromStringFormat(%q) = %v, want %v", tt.input, got.Time, tt.expected)
+			}
+			if tt.loc != nil && got.Location().String() != tt.loc.String() {
+				t.Errorf("FromStringFormat(%q) location = %v, want %v", tt.input, got.Location(), tt.loc)
+			}
+		})
+	}
+}
+
+func TestStringFormatZoneConflictError(t *testing.T) {
+	for _, input := range []string{"2023-04-22T18:22:15+09:00[Asia/Shanghai]", "2023-04-22T18:22:15+09:00 Asia/Shanghai"} {
+		_, err := parse.FromStringFormat(input, parse.WithFromStringLocation(time.UTC))
+		if !errors.Is(err, parse.ErrAmbiguous) {
+			t.Errorf("FromStringFormat(%q) error = %v, want ErrAmbiguous", input, err)
+		}
+		var pe *parse.ParseError
+		if !errors.As(err, &pe) || pe.Component != "zone" {
+			t.Errorf("FromStringFormat(%q) error component = %v, want zone", input, err)
+		}
+	}
+}
+
+func TestStringFormatZoneAbbrSuffixPreference(t *testing.T) {
+	shanghai := time.FixedZone("CST", 8*3600)
+	got, err := parse.FromStringFormat("2023-04-22 18:22:15 CST",
+		parse.WithFromStringLocation(time.UTC), parse.WithFromStringZonePreference("CST", shanghai))
+	if err != nil || !got.Equal(time.Date(2023, 4, 22, 18, 22, 15, 0, shanghai)) {
+		t.Errorf("FromStringFormat(CST with preference) = %v, %v, want %v", got.Time, err, time.Date(2023, 4, 22, 18, 22, 15, 0, shanghai))
+	}
+
+	_, err = parse.FromStringFormat("2023-04-22 18:22:15 CST", parse.WithFromStringLocation(time.UTC), parse.WithFromStringStrict(true))
+	if !errors.Is(err, parse.ErrAmbiguous) {
+		t.Errorf("FromStringFormat(CST strict) error = %v, want ErrAmbiguous", err)
+	}
+}
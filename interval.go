@@ -0,0 +1,194 @@
+package chronos
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gomooth/chronos/internal/parse"
+)
+
+// Interval 时间区间
+type Interval struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Duration 区间的时长
+func (i Interval) Duration() time.Duration {
+	return i.End.Sub(i.Start)
+}
+
+// ParseInterval 解析时间区间，起止时间按 Parse 的规则及选项解析，支持以下形式：
+//   - 起止时间：2023-04-01/2023-04-30、2023-04-01..2023-04-30，结束时间可按字段省略与开始时间相同的高位部分及时区，如 2023-04-01/30、2023-04-22T10:00Z/12:00
+//   - 开始时间及时长：2023-04-01T00:00Z/P1M
+//   - 时长及结束时间：P1W/2023-04-30
+//   - 仅时长：P1D，截止到基准时间（ParseWithBaseTime，默认为当前时间）
+//
+// 时长中的年、月、周、日按日历计算，月末溢出规则与 time.AddDate 一致
+func ParseInterval(s string, opts ...func(*ParseOption)) (*Interval, error) {
+	return parserOf(opts).ParseInterval(s)
+}
+
+// ParseInterval 解析时间区间，规则与 ParseInterval 函数一致
+func (p *Parser) ParseInterval(s string) (*Interval, error) {
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(s, "P") && !strings.Contains(s, "/") {
+		d, err := parse.ParseISODuration(s)
+		if err != nil {
+			return nil, newParseError(s, InputISO8601, err)
+		}
+		end := parse.BaseTime(p.cnf.fromKeywordOptions...)
+		return &Interval{Start: d.SubtractFrom(end), End: end}, nil
+	}
+
+	sep := "/"
+	if strings.Contains(s, "..") {
+		sep = ".."
+	}
+	parts := strings.Split(s, sep)
+	if len(parts) < 2 {
+		return nil, &ParseError{Input: s, Component: "interval", Err: fmt.Errorf("%w: missing / or .. separator", ErrUnsupportedExpression)}
+	}
+
+	// 格式中可能含有分隔符，如 01/02/2006，逐个位置尝试拆分，仅一种拆分有效时采用
+	var found *Interval
+	var err error
+	for i := 1; i < len(parts); i++ {
+		interval, partErr := p.parseIntervalParts(s, strings.Join(parts[:i], sep), strings.Join(parts[i:], sep))
+		if partErr != nil {
+			if err == nil {
+				err = partErr
+			} else {
+				err = preferError(err, partErr)
+			}
+			continue
+		}
+		if found != nil {
+			return nil, &ParseError{Input: s, Component: "interval", Err: fmt.Errorf("%w: %s can be split at more than one %s", ErrAmbiguous, s, sep)}
+		}
+		found = interval
+	}
+	if found == nil {
+		return nil, err
+	}
+	return found, nil
+}
+
+// parseIntervalParts 解析区间的两部分，任意一侧可以是 ISO 8601 时长
+func (p *Parser) parseIntervalParts(s, left, right string) (*Interval, error) {
+	var start, end time.Time
+	switch leftDuration, rightDuration := strings.HasPrefix(left, "P"), strings.HasPrefix(right, "P"); {
+	case leftDuration && rightDuration:
+		return nil, &ParseError{Input: s, Component: "interval", Err: fmt.Errorf("%w: both sides are durations", ErrUnsupportedExpression)}
+	case leftDuration:
+		d, err := parse.ParseISODuration(left)
+		if err != nil {
+			return nil, newParseError(left, InputISO8601, err)
+		}
+		res, err := p.ParseDetailed(right)
+		if err != nil {
+			return nil, err
+		}
+		start, end = d.SubtractFrom(res.Time), res.Time
+	case rightDuration:
+		res, err := p.ParseDetailed(left)
+		if err != nil {
+			return nil, err
+		}
+		d, err := parse.ParseISODuration(right)
+		if err != nil {
+			return nil, newParseError(right, InputISO8601, err)
+		}
+		start, end = res.Time, d.AddTo(res.Time)
+	default:
+		startRes, err := p.ParseDetailed(left)
+		if err != nil {
+			return nil, err
+		}
+		// 结束时间省略了高位部分或时区时，先尝试以开始时间补全，如 2023-04-01/30、2023-04-22T10:00Z/12:00
+		var endRes *ParseResult
+		if full, ok := completeIntervalEnd(left, right); ok {
+			endRes, err = p.ParseDetailed(full)
+		}
+		if endRes == nil {
+			if endRes, err = p.ParseDetailed(right); err != nil {
+				return nil, err
+			}
+		}
+		start, end = startRes.Time, endRes.Time
+	}
+
+	if end.Before(start) {
+		return nil, &ParseError{Input: s, Component: "interval", Err: fmt.Errorf("%w: end %s is before start %s", ErrOutOfRange, end.Format(time.RFC3339Nano), start.Format(time.RFC3339Nano))}
+	}
+	return &Interval{Start: start, End: end}, nil
+}
+
+// completeIntervalEnd 按 ISO 8601 的省略规则，以开始时间补全结束时间：
+//   - 日期按 - 分隔的字段从右对齐，缺少的高位字段取开始日期，如 2023-04-01/30、2023-04-01/05-02
+//   - 仅有时间时沿用开始日期，如 2023-04-22T10:00/12:00
+//   - 有时间但未带时区时沿用开始时间的时区，如 2023-04-22T10:00+08:00/12:00
+//
+// 两部分不是 ISO 8601 形式或无需补全时返回 false
+func completeIntervalEnd(start, end string) (string, bool) {
+	startDate, sep, _, startZone, ok := splitISOParts(start)
+	if !ok {
+		return "", false
+	}
+	endDate, _, endTime, endZone, ok := splitISOParts(end)
+	if !ok {
+		return "", false
+	}
+
+	date := startDate
+	if endDate != "" {
+		startFields, endFields := strings.Split(startDate, "-"), strings.Split(endDate, "-")
+		if len(endFields) > len(startFields) {
+			return "", false
+		}
+		date = strings.Join(append(startFields[:len(startFields)-len(endFields)], endFields...), "-")
+	} else if endTime == "" {
+		return "", false
+	}
+
+	full := date
+	if endTime != "" {
+		if endZone == "" {
+			endZone = startZone
+		}
+		if sep == "" {
+			sep = "T"
+		}
+		full += sep + endTime + endZone
+	}
+	return full, full != end
+}
+
+// splitISOParts 将 ISO 8601 形式的时间拆分为日期、日期与时间的分隔符、时间及时区，
+// 仅有时间时日期为空，日期与时间只能由数字及 - : . , 组成
+func splitISOParts(s string) (date, sep, clock, zone string, ok bool) {
+	date = s
+	if i := strings.IndexAny(s, "T "); i >= 0 {
+		date, sep, clock = s[:i], s[i:i+1], s[i+1:]
+	} else if strings.Contains(s, ":") {
+		date, clock = "", s
+	}
+
+	if strings.HasSuffix(clock, "Z") {
+		clock, zone = clock[:len(clock)-1], "Z"
+	} else if i := strings.LastIndexAny(clock, "+-"); i >= 0 {
+		clock, zone = clock[:i], clock[i:]
+	}
+
+	isoChars := func(v, allowed string) bool {
+		for _, c := range v {
+			if (c < '0' || c > '9') && !strings.ContainsRune(allowed, c) {
+				return false
+			}
+		}
+		return true
+	}
+	ok = isoChars(date, "-") && isoChars(clock, ":.,") && isoChars(zone, "Z+-:") && (date != "" || clock != "")
+	return date, sep, clock, zone, ok
+}
@@ -0,0 +1,70 @@
+package chronos_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gomooth/chronos"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseInterval(t *testing.T) {
+	base := time.Date(2023, 5, 15, 14, 30, 0, 0, time.UTC)
+	opts := []func(*chronos.ParseOption){chronos.ParseWithLocation(time.UTC), chronos.ParseWithBaseTime(base)}
+	date := func(year int, month time.Month, day, hour, minute int) time.Time {
+		return time.Date(year, month, day, hour, minute, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		input      string
+		start, end time.Time
+	}{
+		{"2023-04-01/2023-04-30", date(2023, 4, 1, 0, 0), date(2023, 4, 30, 0, 0)},
+		{"2023-04-01..2023-04-30", date(2023, 4, 1, 0, 0), date(2023, 4, 30, 0, 0)},
+		{"2023-04-01T00:00Z/P1M", date(2023, 4, 1, 0, 0), date(2023, 5, 1, 0, 0)},
+		{"P1W/2023-04-30", date(2023, 4, 23, 0, 0), date(2023, 4, 30, 0, 0)},
+		{"P1DT12H", date(2023, 5, 14, 2, 30), base},
+		{"2023-04-01/30", date(2023, 4, 1, 0, 0), date(2023, 4, 30, 0, 0)},
+		{"2023-04-01T10:00/12:30", date(2023, 4, 1, 10, 0), date(2023, 4, 1, 12, 30)},
+		{"2023-04-01/05-02", date(2023, 4, 1, 0, 0), date(2023, 5, 2, 0, 0)},
+		{"2023-04-22T10:00:00Z/12:00", date(2023, 4, 22, 10, 0), date(2023, 4, 22, 12, 0)},
+		{"2023-04-22T10:00:00+08:00/12:00", date(2023, 4, 22, 2, 0), date(2023, 4, 22, 4, 0)},
+		{"2023-04-22T10:00+08:00/12:00-07:00", date(2023, 4, 22, 2, 0), date(2023, 4, 22, 19, 0)},
+		{"2023-04-22T10:00Z/23T12:00", date(2023, 4, 22, 10, 0), date(2023, 4, 23, 12, 0)},
+		{"2023-04-22 10:00:00/12:00:00", date(2023, 4, 22, 10, 0), date(2023, 4, 22, 12, 0)},
+		{"2023-04-01/PT1.5H", date(2023, 4, 1, 0, 0), date(2023, 4, 1, 1, 30)},
+		// 月末溢出与 time.AddDate 一致
+		{"2023-01-31/P1M", date(2023, 1, 31, 0, 0), date(2023, 3, 3, 0, 0)},
+		{"yesterday/today", date(2023, 5, 14, 0, 0), date(2023, 5, 15, 0, 0)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			interval, err := chronos.ParseInterval(tt.input, opts...)
+			if assert.NoError(t, err) {
+				assert.True(t, tt.start.Equal(interval.Start), "start %s", interval.Start)
+				assert.True(t, tt.end.Equal(interval.End), "end %s", interval.End)
+				assert.Equal(t, tt.end.Sub(tt.start), interval.Duration())
+			}
+		})
+	}
+
+	t.Run("layout with slashes", func(t *testing.T) {
+		interval, err := chronos.ParseInterval("01/04/2023/30/04/2023", append(opts, chronos.ParseWithLayout("02/01/2006"))...)
+		assert.NoError(t, err)
+		assert.Equal(t, date(2023, 4, 1, 0, 0), interval.Start)
+		assert.Equal(t, date(2023, 4, 30, 0, 0), interval.End)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, err := chronos.ParseInterval("2023-04-30/2023-04-01", opts...)
+		assert.ErrorIs(t, err, chronos.ErrOutOfRange)
+		_, err = chronos.ParseInterval("P1Y/P1M", opts...)
+		assert.ErrorIs(t, err, chronos.ErrUnsupportedExpression)
+		_, err = chronos.ParseInterval("2023-04-01/P1X", opts...)
+		assert.ErrorIs(t, err, chronos.ErrUnknownUnit)
+		_, err = chronos.ParseInterval("2023-04-01", opts...)
+		assert.ErrorIs(t, err, chronos.ErrUnsupportedExpression)
+	})
+}
@@ -0,0 +1,16 @@
+package chronos
+
+import (
+	"time"
+
+	"github.com/gomooth/chronos/internal/parse"
+)
+
+// RegisterKeyword 注册时间解析的关键字，名称不区分大小写，可覆盖内置关键字
+// resolve 由基准时间计算关键字对应的时间，基准时间可通过 ParseWithBaseTime 指定，默认为当前时间
+// precision 为关键字表示的精度，如瞬时为 PrecisionNanosecond，某一天为 PrecisionDay
+//
+// 内置关键字：now 为基准时间；today、yesterday、tomorrow 分别为当天、前一天、后一天的零点
+func RegisterKeyword(name string, precision Precision, resolve func(base time.Time) time.Time) error {
+	return parse.RegisterKeyword(name, parse.Keyword{Resolve: resolve, Precision: precision})
+}
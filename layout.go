@@ -0,0 +1,13 @@
+package chronos
+
+import "github.com/gomooth/chronos/internal/parse"
+
+// LayoutConflictError 部分样本无法按推断出的格式解析，包含该格式及冲突的样本
+type LayoutConflictError = parse.LayoutConflictError
+
+// InferLayout 根据样本推断 Go 时间格式，结果可直接用于 ParseWithLayout
+// 日与月的顺序由大于 12 的值确定，无法确定时返回 ErrAmbiguous；
+// 推断出的格式无法解析全部样本时，同时返回按多数样本推断出的格式及 *LayoutConflictError
+func InferLayout(samples []string) (layout string, err error) {
+	return parse.InferLayout(samples)
+}
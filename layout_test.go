@@ -0,0 +1,39 @@
+package chronos_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gomooth/chronos"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInferLayout(t *testing.T) {
+	t.Run("parse with inferred layout", func(t *testing.T) {
+		samples := []string{"22/04/2023 18:22", "01/05/2023 07:05"}
+		layout, err := chronos.InferLayout(samples)
+		assert.NoError(t, err)
+		assert.Equal(t, "02/01/2006 15:04", layout)
+
+		at, err := chronos.Parse(samples[0], chronos.ParseWithLayout(layout), chronos.ParseWithLocation(time.UTC))
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2023, 4, 22, 18, 22, 0, 0, time.UTC), *at)
+	})
+
+	t.Run("ambiguous", func(t *testing.T) {
+		_, err := chronos.InferLayout([]string{"04/05/2023", "06/07/2023"})
+		assert.ErrorIs(t, err, chronos.ErrAmbiguous)
+	})
+
+	t.Run("conflict", func(t *testing.T) {
+		layout, err := chronos.InferLayout([]string{"22/04/2023", "04/23/2023", "25/12/2023"})
+		assert.Equal(t, "02/01/2006", layout)
+
+		var conflict *chronos.LayoutConflictError
+		if assert.ErrorAs(t, err, &conflict) {
+			assert.Equal(t, []int{1}, conflict.Indexes)
+			assert.Equal(t, []string{"04/23/2023"}, conflict.Samples)
+		}
+	})
+}
@@ -0,0 +1,81 @@
+package chronos
+
+import (
+	"time"
+
+	"github.com/gomooth/chronos/internal/parse"
+)
+
+// InputKind 解析输入的类型
+type InputKind = parse.Kind
+
+const (
+	InputUnknown         = parse.KindUnknown
+	InputTime            = parse.KindTime            // time.Time 或 *time.Time
+	InputTimestamp       = parse.KindTimestamp       // 数值时间戳
+	InputLayout          = parse.KindLayout          // 按格式匹配的字符串
+	InputKeyword         = parse.KindKeyword         // 关键字，如 now、yesterday
+	InputNaturalLanguage = parse.KindNaturalLanguage // 自然语言表达式
+	InputTokens          = parse.KindTokens          // 按分词识别的字符串
+	InputISO8601         = parse.KindISO8601         // 按 ISO 8601 语法解析的字符串
+	InputChinese         = parse.KindChinese         // 中文日期时间
+	InputExcelSerial     = parse.KindExcelSerial     // 电子表格序列号
+)
+
+// TimestampUnit 时间戳单位
+type TimestampUnit = parse.Unit
+
+const (
+	UnitNone        = parse.UnitNone
+	UnitSecond      = parse.UnitSecond
+	UnitMillisecond = parse.UnitMillisecond
+	UnitMicrosecond = parse.UnitMicrosecond
+	UnitNanosecond  = parse.UnitNanosecond
+)
+
+// Precision 输入实际携带的时间精度
+type Precision = parse.Precision
+
+const (
+	PrecisionUnknown     = parse.PrecisionUnknown
+	PrecisionYear        = parse.PrecisionYear
+	PrecisionMonth       = parse.PrecisionMonth
+	PrecisionDay         = parse.PrecisionDay
+	PrecisionHour        = parse.PrecisionHour
+	PrecisionMinute      = parse.PrecisionMinute
+	PrecisionSecond      = parse.PrecisionSecond
+	PrecisionMillisecond = parse.PrecisionMillisecond
+	PrecisionMicrosecond = parse.PrecisionMicrosecond
+	PrecisionNanosecond  = parse.PrecisionNanosecond
+)
+
+// Normalization 解析时对输入做的规范化
+type Normalization = parse.Normalization
+
+const (
+	NormalizationNone       = parse.NormalizationNone
+	NormalizationEndOfDay   = parse.NormalizationEndOfDay   // 24:00:00 转换为次日零点
+	NormalizationLeapSecond = parse.NormalizationLeapSecond // 闰秒 23:59:60 按策略转换
+)
+
+// ParseResult 时间解析的详细结果
+type ParseResult struct {
+	Time      time.Time     // 解析得到的时间
+	Layout    string        // 匹配的格式，仅字符串按格式解析时有值
+	Kind      InputKind     // 输入类型
+	Unit      TimestampUnit // 推断的时间戳单位，仅数值时间戳有值
+	Precision Precision     // 输入实际携带的精度
+
+	Normalized Normalization // 对输入做的规范化，见 ParseWithTimeNormalization
+}
+
+func newParseResult(res parse.Result) *ParseResult {
+	return &ParseResult{
+		Time:       res.Time,
+		Layout:     res.Layout,
+		Kind:       res.Kind,
+		Unit:       res.Unit,
+		Precision:  res.Precision,
+		Normalized: res.Normalized,
+	}
+}
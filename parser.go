@@ -1,66 +1,333 @@
 package chronos
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync/atomic"
 	"time"
+	"unsafe"
 
 	"github.com/gomooth/chronos/internal/parse"
 )
 
 type ParseOption struct {
-	fromStringOptions   []func(*parse.FromStringOption)
+	fromUnixOptions    []func(*parse.FromUnixOption)
+	fromStringOptions  []func(*parse.FromStringOption)
+	noNumericString    bool
+	fromKeywordOptions []func(*parse.FromKeywordOption)
+	fromTokens         struct {
+		supported bool
+		options   []func(*parse.FromTokensOption)
+	}
 	fromNaturalLanguage struct {
 		supported bool
 		options   []func(*parse.FromNaturalLanguageOption)
 	}
+	fromChinese struct {
+		supported bool
+		options   []func(*parse.FromChineseOption)
+	}
+	fromExcel struct {
+		supported bool
+		options   []func(*parse.FromExcelOption)
+	}
+	fromEpoch struct {
+		supported bool
+		options   []func(*parse.FromEpochOption)
+	}
+	locale  Locale
+	workers int // 批量解析的并发数
 }
 
 // Parse 时间解析
 func Parse[T TimeValue](v T, opts ...func(*ParseOption)) (*time.Time, error) {
+	return parserOf(opts).Parse(v)
+}
+
+// ParseDetailed 时间解析，同时返回匹配的格式、输入类型、时间戳单位及精度等信息
+func ParseDetailed[T TimeValue](v T, opts ...func(*ParseOption)) (*ParseResult, error) {
+	return parserOf(opts).ParseDetailed(v)
+}
+
+// defaultParser 不带选项时复用的解析器，time.Local 变化后重新创建
+var defaultParser atomic.Pointer[localParser]
+
+type localParser struct {
+	*Parser
+	local *time.Location
+}
+
+// parserOf 不带选项时复用默认解析器，否则按选项创建
+func parserOf(opts []func(*ParseOption)) *Parser {
+	if len(opts) > 0 {
+		return NewParser(opts...)
+	}
+	if p := defaultParser.Load(); p != nil && p.local == time.Local {
+		return p.Parser
+	}
+	p := &localParser{Parser: NewParser(), local: time.Local}
+	defaultParser.Store(p)
+	return p.Parser
+}
+
+// Parser 可复用的时间解析器
+// 选项及格式列表只在创建时整理一次，创建后只读，可在多个 goroutine 中并发使用
+type Parser struct {
+	cnf          *ParseOption
+	stringFormat *parse.StringFormat
+	locale       *parse.Locale
+	err          error // 选项错误，解析字符串时返回
+}
+
+// NewParser 创建时间解析器，适用于大量输入使用相同选项解析的场景
+func NewParser(opts ...func(*ParseOption)) *Parser {
 	cnf := new(ParseOption)
 	for _, opt := range opts {
 		opt(cnf)
 	}
 
-	now := time.Now()
-	switch val := any(v).(type) {
+	// 纯数字字符串优先按时间戳解析，仅关闭时间戳解析时才按 ISO 8601 基本格式解析
+	stringOptions := append(cnf.fromStringOptions[:len(cnf.fromStringOptions):len(cnf.fromStringOptions)],
+		parse.WithFromStringDigitOnlyISO(cnf.noNumericString))
+	p := &Parser{
+		cnf:          cnf,
+		stringFormat: parse.NewStringFormat(stringOptions...),
+	}
+	if cnf.locale != "" {
+		if l, ok := parse.LookupLocale(string(cnf.locale)); ok {
+			p.locale = l
+		} else {
+			p.err = &ParseError{Component: "locale", Err: fmt.Errorf("%w: unknown locale %s", ErrUnsupportedExpression, cnf.locale)}
+		}
+	}
+	return p
+}
+
+// Parse 时间解析，v 支持 TimeValue 中的所有类型
+func (p *Parser) Parse(v any) (*time.Time, error) {
+	if at, ok := v.(*time.Time); ok && at != nil {
+		return at, nil
+	}
+
+	res, err := p.ParseDetailed(v)
+	if err != nil {
+		return nil, err
+	}
+	return &res.Time, nil
+}
+
+// ParseDetailed 时间解析，同时返回匹配的格式、输入类型、时间戳单位及精度等信息
+func (p *Parser) ParseDetailed(v any) (*ParseResult, error) {
+	switch val := v.(type) {
 	case time.Time:
-		return &val, nil
+		return &ParseResult{Time: val, Kind: InputTime, Precision: PrecisionNanosecond}, nil
 	case *time.Time:
-		return val, nil
-	case int, int16, int32, int64, uint, uint16, uint32, uint64, uintptr:
-		t, err := parse.FromUnixTime(val)
+		if val == nil {
+			return nil, &ParseError{Kind: InputTime, Err: errors.New("invalid time: nil pointer")}
+		}
+		return &ParseResult{Time: *val, Kind: InputTime, Precision: PrecisionNanosecond}, nil
+	case string:
+		return p.parseString(val)
+	case []byte:
+		return p.parseBytes(val)
+	case json.Number:
+		return p.parseJSONNumber(val)
+	case float64:
+		return p.parseFloat(val, 64)
+	case float32:
+		return p.parseFloat(float64(val), 32)
+	}
+
+	// 以字符串、字节切片、浮点数或整数为底层类型的自定义类型
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.String:
+		return p.parseString(rv.String())
+	case reflect.Slice:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			return p.parseBytes(rv.Bytes())
+		}
+	case reflect.Float64:
+		return p.parseFloat(rv.Float(), 64)
+	case reflect.Float32:
+		return p.parseFloat(rv.Float(), 32)
+	}
+
+	if p.cnf.fromExcel.supported {
+		res, err := parse.FromExcelSerial(v, p.cnf.fromExcel.options...)
 		if err != nil {
-			return nil, fmt.Errorf("invalid unix time: %w", err)
+			return nil, newParseError(fmt.Sprint(v), InputExcelSerial, err)
 		}
-		return &t, nil
+		return newParseResult(res), nil
+	}
+
+	if p.cnf.fromEpoch.supported {
+		res, err := parse.FromEpoch(v, p.cnf.fromEpoch.options...)
+		if err != nil {
+			return nil, newParseError(fmt.Sprint(v), InputTimestamp, err)
+		}
+		return newParseResult(res), nil
+	}
+
+	res, err := parse.FromUnixTime(v, p.cnf.fromUnixOptions...)
+	if err != nil {
+		return nil, newParseError(fmt.Sprint(v), InputTimestamp, err)
+	}
+	return newParseResult(res), nil
+}
+
+// parseBytes 不复制字节切片，直接按字符串解析
+// 结果及错误中引用输入内存的部分（时区名、原始输入）会被复制，调用方之后修改切片不影响结果
+func (p *Parser) parseBytes(b []byte) (*ParseResult, error) {
+	str := unsafe.String(unsafe.SliceData(b), len(b))
+	res, err := p.parseString(str)
+	if err != nil {
+		if pe, ok := err.(*ParseError); ok {
+			pe.Input = string(b)
+		}
+		return nil, err
+	}
+	if name, offset := res.Time.Zone(); len(name) > 0 && len(b) > 0 {
+		start := uintptr(unsafe.Pointer(unsafe.SliceData(b)))
+		if at := uintptr(unsafe.Pointer(unsafe.StringData(name))); at >= start && at < start+uintptr(len(b)) {
+			res.Time = res.Time.In(time.FixedZone(strings.Clone(name), offset))
+		}
+	}
+	return res, nil
+}
+
+// parseFloat 浮点数按最短的十进制表示解析，避免二进制误差，如 1672643045.123 的小数部分为 123 毫秒
+func (p *Parser) parseFloat(f float64, bitSize int) (*ParseResult, error) {
+	str := strconv.FormatFloat(f, 'f', -1, bitSize)
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return nil, &ParseError{Input: str, Kind: InputTimestamp, Component: "value", Err: fmt.Errorf("%w: %s", ErrOutOfRange, str)}
+	}
+	return p.parseNumber(str)
+}
+
+// parseJSONNumber 按数字解析，科学计数法（如 "1.672643045e9"）展开为十进制
+func (p *Parser) parseJSONNumber(n json.Number) (*ParseResult, error) {
+	str := string(n)
+	if !parse.IsNumericString(str) {
+		r, ok := new(big.Rat).SetString(str)
+		if !ok {
+			return nil, &ParseError{Input: str, Kind: InputTimestamp, Err: fmt.Errorf("%w: invalid number %s", ErrUnsupportedExpression, str)}
+		}
+		str = strings.TrimRight(strings.TrimRight(r.FloatString(9), "0"), ".")
+	}
+	return p.parseNumber(str)
+}
+
+// parseNumber 解析数字，按选项作为电子表格序列号、指定纪元的计数或时间戳
+func (p *Parser) parseNumber(str string) (*ParseResult, error) {
+	cnf := p.cnf
+	var res parse.Result
+	var err error
+	kind := InputTimestamp
+	switch {
+	case cnf.fromExcel.supported:
+		res, err = parse.FromExcelSerialString(str, cnf.fromExcel.options...)
+		kind = InputExcelSerial
+	case cnf.fromEpoch.supported:
+		res, err = parse.FromEpochString(str, cnf.fromEpoch.options...)
 	default:
-		str := val.(string)
-		switch str {
-		case "now":
-			return &now, nil
-		case "yesterday":
-			at := Yesterday(now)
-			return &at, nil
-		case "tomorrow":
-			at := Tomorrow(now)
-			return &at, nil
-		default:
-			// 尝试解析字符串为时间
-			parsed, err := parse.FromStringFormat(str, cnf.fromStringOptions...)
-			if err != nil {
-				if !cnf.fromNaturalLanguage.supported {
-					return nil, fmt.Errorf("invalid time string: %w", err)
-				}
-				// 尝试解析自然语言
-				parsed, err = parse.FromNaturalLanguage(str, cnf.fromNaturalLanguage.options...)
-				if err != nil {
-					return nil, fmt.Errorf("invalid time string: %w", err)
-				}
-			}
-			return &parsed, nil
+		res, err = parse.FromNumericString(str, cnf.fromUnixOptions...)
+	}
+	if err != nil {
+		return nil, newParseError(str, kind, err)
+	}
+	return newParseResult(res), nil
+}
+
+func (p *Parser) parseString(str string) (*ParseResult, error) {
+	if p.err != nil {
+		return nil, newParseError(str, InputUnknown, p.err)
+	}
+	cnf := p.cnf
+
+	// 先判断是否为关键字，非关键字输入不生成错误
+	if parse.IsKeyword(str) {
+		if res, err := parse.FromKeyword(str, cnf.fromKeywordOptions...); err == nil {
+			return newParseResult(res), nil
+		}
+	}
+
+	// 数字形式的字符串按电子表格序列号或指定纪元解析，不再尝试格式及时间戳
+	if cnf.fromExcel.supported && parse.IsNumericString(str) {
+		res, err := parse.FromExcelSerialString(str, cnf.fromExcel.options...)
+		if err != nil {
+			return nil, newParseError(str, InputExcelSerial, err)
+		}
+		return newParseResult(res), nil
+	}
+	if cnf.fromEpoch.supported && parse.IsNumericString(str) {
+		res, err := parse.FromEpochString(str, cnf.fromEpoch.options...)
+		if err != nil {
+			return nil, newParseError(str, InputTimestamp, err)
+		}
+		return newParseResult(res), nil
+	}
+
+	// 将本地化的月份、星期、上下午名称翻译为英文
+	raw := str
+	if p.locale != nil {
+		str = p.locale.Translate(str)
+	}
+
+	// 尝试解析字符串为时间，均失败时返回最具体的错误
+	res, err := p.stringFormat.Parse(str)
+	if errors.Is(err, ErrAmbiguous) {
+		return nil, newParseError(raw, InputLayout, err)
+	}
+	if err != nil && !cnf.noNumericString {
+		// 尝试解析数字形式的时间戳
+		numeric, numErr := parse.FromNumericString(str, cnf.fromUnixOptions...)
+		if numErr == nil {
+			res, err = numeric, nil
+		} else {
+			err = preferError(err, numErr)
+		}
+	}
+	if err != nil && cnf.fromChinese.supported {
+		// 尝试解析中文日期时间
+		chinese, cnErr := parse.FromChinese(raw, cnf.fromChinese.options...)
+		if cnErr == nil {
+			res, err = chinese, nil
+		} else {
+			err = preferError(err, cnErr)
+		}
+	}
+	if err != nil && (cnf.fromTokens.supported || p.locale != nil) {
+		// 尝试按分词解析
+		tokens, tokErr := parse.FromTokens(str, cnf.fromTokens.options...)
+		if errors.Is(tokErr, ErrAmbiguous) {
+			return nil, newParseError(raw, InputTokens, tokErr)
 		}
+		if tokErr == nil {
+			res, err = tokens, nil
+		} else {
+			err = preferError(err, tokErr)
+		}
+	}
+	if err != nil && cnf.fromNaturalLanguage.supported {
+		// 尝试解析自然语言
+		natural, nlErr := parse.FromNaturalLanguage(str, cnf.fromNaturalLanguage.options...)
+		if nlErr == nil {
+			res, err = natural, nil
+		} else {
+			err = preferError(err, nlErr)
+		}
+	}
+	if err != nil {
+		return nil, newParseError(raw, InputUnknown, err)
 	}
+	return newParseResult(res), nil
 }
 
 // ParseWithLayout 指定时间解析的自定义格式
@@ -76,28 +343,253 @@ func ParseWithLayout(layout string, others ...string) func(*ParseOption) {
 	}
 }
 
+// ParseWithUnixUnit 指定数值时间戳的单位，不再按数值大小自动识别
+func ParseWithUnixUnit(unit TimestampUnit) func(*ParseOption) {
+	return func(p *ParseOption) {
+		if p.fromUnixOptions == nil {
+			p.fromUnixOptions = make([]func(*parse.FromUnixOption), 0)
+		}
+		p.fromUnixOptions = append(p.fromUnixOptions, parse.WithFromUnixUnit(unit))
+	}
+}
+
+// ParseWithDateOrder 指定纯数字日期的年月日顺序，并启用对应的 / . - 分隔格式
+// 如 DMY 时 "03/04/2023" 解析为 4 月 3 日，MDY 时解析为 3 月 4 日
+func ParseWithDateOrder(order DateOrder) func(*ParseOption) {
+	return func(p *ParseOption) {
+		if p.fromStringOptions == nil {
+			p.fromStringOptions = make([]func(*parse.FromStringOption), 0)
+		}
+		p.fromStringOptions = append(p.fromStringOptions, parse.WithFromStringDateOrder(order))
+
+		if p.fromTokens.options == nil {
+			p.fromTokens.options = make([]func(*parse.FromTokensOption), 0)
+		}
+		p.fromTokens.options = append(p.fromTokens.options, parse.WithFromTokensDateOrder(order))
+	}
+}
+
+// ParseWithStrict 指定是否为严格模式
+// 严格模式下，存在多种有效解读的输入（如日与月可以互换的 "03/04/2023"）将返回错误
+func ParseWithStrict(strict bool) func(*ParseOption) {
+	return func(p *ParseOption) {
+		if p.fromStringOptions == nil {
+			p.fromStringOptions = make([]func(*parse.FromStringOption), 0)
+		}
+		p.fromStringOptions = append(p.fromStringOptions, parse.WithFromStringStrict(strict))
+
+		if p.fromTokens.options == nil {
+			p.fromTokens.options = make([]func(*parse.FromTokensOption), 0)
+		}
+		p.fromTokens.options = append(p.fromTokens.options, parse.WithFromTokensStrict(strict))
+	}
+}
+
+// ParseWithTimeNormalization 规范化超出常规范围的时间：24:00:00 转换为次日零点，闰秒 23:59:60 按 leap 指定的策略处理
+// 发生规范化时，ParseResult.Normalized 记录规范化的类型；leap 为 LeapSecondReject 时闰秒仍返回错误
+func ParseWithTimeNormalization(leap LeapSecond) func(*ParseOption) {
+	return func(p *ParseOption) {
+		if p.fromStringOptions == nil {
+			p.fromStringOptions = make([]func(*parse.FromStringOption), 0)
+		}
+		p.fromStringOptions = append(p.fromStringOptions, parse.WithFromStringNormalize(leap))
+	}
+}
+
+// ParseWithLenient 指定是否为宽松模式，默认关闭
+// 宽松模式下，输入按格式无法解析时，整理首尾及连续空白、大小写、序数词后缀（st/nd/rd/th）、可省略的逗号及缩写后的句点后再次尝试，
+// 并额外支持 "April 22 2023"、"22 Apr 2023"、"Sat Apr 22 2023" 等手写日期格式
+func ParseWithLenient(lenient bool) func(*ParseOption) {
+	return func(p *ParseOption) {
+		if p.fromStringOptions == nil {
+			p.fromStringOptions = make([]func(*parse.FromStringOption), 0)
+		}
+		p.fromStringOptions = append(p.fromStringOptions, parse.WithFromStringLenient(lenient))
+	}
+}
+
+// ParseWithZonePreference 指定时区缩写对应的时区，优先于内置的缩写表
+// 如 "CST" 默认解读为美国中部时间，可通过 ParseWithZonePreference("CST", shanghai) 改为中国标准时间
+func ParseWithZonePreference(abbr string, loc *time.Location) func(*ParseOption) {
+	return func(p *ParseOption) {
+		if p.fromStringOptions == nil {
+			p.fromStringOptions = make([]func(*parse.FromStringOption), 0)
+		}
+		p.fromStringOptions = append(p.fromStringOptions, parse.WithFromStringZonePreference(abbr, loc))
+
+		if p.fromTokens.options == nil {
+			p.fromTokens.options = make([]func(*parse.FromTokensOption), 0)
+		}
+		p.fromTokens.options = append(p.fromTokens.options, parse.WithFromTokensZonePreference(abbr, loc))
+	}
+}
+
+// ParseWithZoneConflict 指定输入中的时区名与数字偏移不一致时的处理方式，默认返回错误
+// 如 "2023-04-22T18:22:15+09:00[Asia/Shanghai]"
+func ParseWithZoneConflict(conflict ZoneConflict) func(*ParseOption) {
+	return func(p *ParseOption) {
+		if p.fromStringOptions == nil {
+			p.fromStringOptions = make([]func(*parse.FromStringOption), 0)
+		}
+		p.fromStringOptions = append(p.fromStringOptions, parse.WithFromStringZoneConflict(conflict))
+	}
+}
+
+// ParseWithLocale 指定输入的语言，解析前将该语言的月份、星期、上下午名称翻译为英文
+// 指定语言后，所有格式均不匹配时会按分词识别，如 "22 avril 2023"、"2023年4月22日 星期六"
+func ParseWithLocale(locale Locale) func(*ParseOption) {
+	return func(p *ParseOption) {
+		p.locale = locale
+	}
+}
+
+// ParseWithExcelSerial 指定数值及数字形式的字符串按电子表格序列号解析，不再视为 Unix 时间戳
+// 如 1900 日期系统中 45038.765 为 2023-04-22 18:21:36，结果为指定时区的墙上时间
+func ParseWithExcelSerial(system ExcelSystem) func(*ParseOption) {
+	return func(p *ParseOption) {
+		p.fromExcel.supported = system != parse.ExcelSystemNone
+		if p.fromExcel.options == nil {
+			p.fromExcel.options = make([]func(*parse.FromExcelOption), 0)
+		}
+		p.fromExcel.options = append(p.fromExcel.options, parse.WithFromExcelSystem(system))
+	}
+}
+
+// ParseWithEpoch 指定数值及数字形式的字符串按纪元计数解析，不再视为 Unix 时间戳
+// 如 EpochFileTime 下 133266613350000000 为 2023-04-22 18:22:15 UTC
+func ParseWithEpoch(epoch Epoch) func(*ParseOption) {
+	return func(p *ParseOption) {
+		p.fromEpoch.supported = true
+		if p.fromEpoch.options == nil {
+			p.fromEpoch.options = make([]func(*parse.FromEpochOption), 0)
+		}
+		p.fromEpoch.options = append(p.fromEpoch.options, parse.WithFromEpoch(epoch))
+	}
+}
+
+// ParseWithNumericString 指定字符串解析是否支持数字形式的时间戳，默认支持
+// 关闭后仅按格式解析字符串
+func ParseWithNumericString(supported bool) func(*ParseOption) {
+	return func(p *ParseOption) {
+		p.noNumericString = !supported
+	}
+}
+
 // ParseWithLocation 指定时间解析的时区
 func ParseWithLocation(loc *time.Location) func(*ParseOption) {
 	return func(p *ParseOption) {
+		if p.fromKeywordOptions == nil {
+			p.fromKeywordOptions = make([]func(*parse.FromKeywordOption), 0)
+		}
+		p.fromKeywordOptions = append(p.fromKeywordOptions, parse.WithFromKeywordLocation(loc))
+
 		if p.fromStringOptions == nil {
 			p.fromStringOptions = make([]func(*parse.FromStringOption), 0)
 		}
 		p.fromStringOptions = append(p.fromStringOptions, parse.WithFromStringLocation(loc))
 
+		if p.fromTokens.options == nil {
+			p.fromTokens.options = make([]func(*parse.FromTokensOption), 0)
+		}
+		p.fromTokens.options = append(p.fromTokens.options, parse.WithFromTokensLocation(loc))
+
 		if p.fromNaturalLanguage.options == nil {
 			p.fromNaturalLanguage.options = make([]func(*parse.FromNaturalLanguageOption), 0)
 		}
 		p.fromNaturalLanguage.options = append(p.fromNaturalLanguage.options, parse.WithFromNaturalLanguageLocation(loc))
+
+		if p.fromChinese.options == nil {
+			p.fromChinese.options = make([]func(*parse.FromChineseOption), 0)
+		}
+		p.fromChinese.options = append(p.fromChinese.options, parse.WithFromChineseLocation(loc))
+
+		if p.fromExcel.options == nil {
+			p.fromExcel.options = make([]func(*parse.FromExcelOption), 0)
+		}
+		p.fromExcel.options = append(p.fromExcel.options, parse.WithFromExcelLocation(loc))
 	}
 }
 
-// ParseWithBaseTime 指定时间解析的时区
+// ParseWithBaseTime 指定时间解析的基准时间，用于关键字、分词、自然语言及中文日期时间，以及推断不含年份的格式的年份、锚定仅含时间的输入
 func ParseWithBaseTime(base time.Time) func(*ParseOption) {
 	return func(p *ParseOption) {
+		if p.fromStringOptions == nil {
+			p.fromStringOptions = make([]func(*parse.FromStringOption), 0)
+		}
+		p.fromStringOptions = append(p.fromStringOptions, parse.WithFromStringBaseTime(base))
+
+		if p.fromKeywordOptions == nil {
+			p.fromKeywordOptions = make([]func(*parse.FromKeywordOption), 0)
+		}
+		p.fromKeywordOptions = append(p.fromKeywordOptions, parse.WithFromKeywordBaseTime(base))
+
+		if p.fromTokens.options == nil {
+			p.fromTokens.options = make([]func(*parse.FromTokensOption), 0)
+		}
+		p.fromTokens.options = append(p.fromTokens.options, parse.WithFromTokensBaseTime(base))
+
 		if p.fromNaturalLanguage.options == nil {
 			p.fromNaturalLanguage.options = make([]func(*parse.FromNaturalLanguageOption), 0)
 		}
 		p.fromNaturalLanguage.options = append(p.fromNaturalLanguage.options, parse.WithFromNaturalLanguageBaseTime(base))
+
+		if p.fromChinese.options == nil {
+			p.fromChinese.options = make([]func(*parse.FromChineseOption), 0)
+		}
+		p.fromChinese.options = append(p.fromChinese.options, parse.WithFromChineseBaseTime(base))
+	}
+}
+
+// ParseWithAnchorDate 将仅含时间的输入（如 "15:04:05"、"3:04PM"）置于指定日期，时间按解析时区计算
+// date 为零值时取基准时间（ParseWithBaseTime，默认为当前时间）的日期；未指定时仍返回公元 0 年 1 月 1 日
+func ParseWithAnchorDate(date time.Time) func(*ParseOption) {
+	return func(p *ParseOption) {
+		if p.fromStringOptions == nil {
+			p.fromStringOptions = make([]func(*parse.FromStringOption), 0)
+		}
+		p.fromStringOptions = append(p.fromStringOptions, parse.WithFromStringAnchorDate(date))
+	}
+}
+
+// ParseWithNextOccurrence 仅含时间的输入取下一次出现的时间：置于锚定日期（未指定时为基准时间的日期），早于基准时间时顺延到下一天
+// 如基准时间为 18:00 时，"09:00" 解析为次日 9 点
+func ParseWithNextOccurrence(next bool) func(*ParseOption) {
+	return func(p *ParseOption) {
+		if p.fromStringOptions == nil {
+			p.fromStringOptions = make([]func(*parse.FromStringOption), 0)
+		}
+		p.fromStringOptions = append(p.fromStringOptions, parse.WithFromStringNextOccurrence(next))
+	}
+}
+
+// ParseWithTwoDigitYearPivot 指定两位年份的转换窗口为 pivot~pivot+99，用于内置及自定义格式、分词及中文日期中的两位年份
+// 如 pivot 为 1930 时，"29" 为 2029，"30" 为 1930；默认与 time.Parse 一致，窗口为 1969~2068
+func ParseWithTwoDigitYearPivot(pivot int) func(*ParseOption) {
+	return withTwoDigitYear(parse.TwoDigitYearPivot(pivot))
+}
+
+// ParseWithTwoDigitYearWindow 两位年份按随基准时间滑动的窗口转换，窗口截止到基准年份之后 ahead 年
+// 如基准年份为 2024、ahead 为 0 时，窗口为 1925~2024，适用于出生日期等不会晚于当前的数据
+func ParseWithTwoDigitYearWindow(ahead int) func(*ParseOption) {
+	return withTwoDigitYear(parse.TwoDigitYearSlidingWindow(ahead))
+}
+
+func withTwoDigitYear(rule parse.TwoDigitYear) func(*ParseOption) {
+	return func(p *ParseOption) {
+		if p.fromStringOptions == nil {
+			p.fromStringOptions = make([]func(*parse.FromStringOption), 0)
+		}
+		p.fromStringOptions = append(p.fromStringOptions, parse.WithFromStringTwoDigitYear(rule))
+
+		if p.fromTokens.options == nil {
+			p.fromTokens.options = make([]func(*parse.FromTokensOption), 0)
+		}
+		p.fromTokens.options = append(p.fromTokens.options, parse.WithFromTokensTwoDigitYear(rule))
+
+		if p.fromChinese.options == nil {
+			p.fromChinese.options = make([]func(*parse.FromChineseOption), 0)
+		}
+		p.fromChinese.options = append(p.fromChinese.options, parse.WithFromChineseTwoDigitYear(rule))
 	}
 }
 
@@ -107,3 +599,29 @@ func ParseWithNaturalLanguage(supported bool) func(*ParseOption) {
 		p.fromNaturalLanguage.supported = supported
 	}
 }
+
+// ParseWithChinese 指定时间解析是否支持中文日期时间，缺失的日期字段取基准时间
+// 如 "2023年4月22日 18时22分15秒"、"4月22日"、"晚上8点半"、"二〇二三年四月二十二日"
+func ParseWithChinese(supported bool) func(*ParseOption) {
+	return func(p *ParseOption) {
+		p.fromChinese.supported = supported
+	}
+}
+
+// ParseWithTokens 指定时间解析是否支持分词识别，不依赖固定格式识别年、月、日、时间、时区等字段
+// 如 "Sat April 22 2023 6:22pm"、"22 Apr 2023 18h22"、"2023.4.22"
+func ParseWithTokens(supported bool) func(*ParseOption) {
+	return func(p *ParseOption) {
+		p.fromTokens.supported = supported
+	}
+}
+
+// ParseWithSkipUnknownTokens 分词识别时是否跳过无法识别的词，默认遇到未知词返回错误
+func ParseWithSkipUnknownTokens(skip bool) func(*ParseOption) {
+	return func(p *ParseOption) {
+		if p.fromTokens.options == nil {
+			p.fromTokens.options = make([]func(*parse.FromTokensOption), 0)
+		}
+		p.fromTokens.options = append(p.fromTokens.options, parse.WithFromTokensSkipUnknown(skip))
+	}
+}
@@ -1,6 +1,10 @@
 package chronos_test
 
 import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sync"
 	"testing"
 	"time"
 
@@ -86,6 +90,50 @@ func TestParse_IntegerInput(t *testing.T) {
 		assert.Equal(t, *at, time.Unix(int64(v), 0))
 	})
 
+	t.Run("int milliseconds", func(t *testing.T) {
+		at, err := chronos.Parse(1672643045123)
+		assert.NoError(t, err)
+		assert.Equal(t, *at, time.Unix(1672643045, 123000000))
+	})
+
+	t.Run("uint64 microseconds", func(t *testing.T) {
+		v := uint64(1672643045123456)
+		at, err := chronos.Parse(v)
+		assert.NoError(t, err)
+		assert.Equal(t, *at, time.Unix(1672643045, 123456000))
+	})
+
+	t.Run("named integer type", func(t *testing.T) {
+		type epoch int64
+		at, err := chronos.Parse(epoch(1672643045123))
+		assert.NoError(t, err)
+		assert.Equal(t, *at, time.Unix(1672643045, 123000000))
+	})
+
+	t.Run("negative seconds", func(t *testing.T) {
+		at, err := chronos.Parse(int64(-86400))
+		assert.NoError(t, err)
+		assert.Equal(t, *at, time.Unix(-86400, 0))
+	})
+
+	t.Run("negative milliseconds", func(t *testing.T) {
+		at, err := chronos.Parse(int64(-631152000123))
+		assert.NoError(t, err)
+		assert.Equal(t, *at, time.UnixMilli(-631152000123))
+	})
+
+	t.Run("forced unit", func(t *testing.T) {
+		at, err := chronos.Parse(int64(1672643045), chronos.ParseWithUnixUnit(chronos.UnitMillisecond))
+		assert.NoError(t, err)
+		assert.Equal(t, *at, time.UnixMilli(1672643045))
+	})
+
+	t.Run("forced unit out of range", func(t *testing.T) {
+		at, err := chronos.Parse(int64(1672643045123), chronos.ParseWithUnixUnit(chronos.UnitSecond))
+		assert.Error(t, err)
+		assert.Nil(t, at)
+	})
+
 	t.Run("int64 too large", func(t *testing.T) {
 		v := uint64(1<<64 - 1)
 		at, err := chronos.Parse(v)
@@ -223,3 +271,880 @@ func TestParse_NaturalLanguage(t *testing.T) {
 		assert.Nil(t, at)
 	})
 }
+
+func TestParseDetailed(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		opts      []func(*chronos.ParseOption)
+		layout    string
+		kind      chronos.InputKind
+		precision chronos.Precision
+	}{
+		{"RFC3339", "2023-04-22T18:22:15Z", nil, time.RFC3339, chronos.InputLayout, chronos.PrecisionSecond},
+		{"RFC3339 with millis", "2023-04-22T18:22:15.123Z", nil, time.RFC3339, chronos.InputLayout, chronos.PrecisionMillisecond},
+		{"RFC3339Nano", "2023-04-22T18:22:15.123456789Z", nil, time.RFC3339, chronos.InputLayout, chronos.PrecisionNanosecond},
+		{"Kitchen", "6:22PM", nil, time.Kitchen, chronos.InputLayout, chronos.PrecisionMinute},
+		{"DateOnly", "2023-04-22", nil, time.DateOnly, chronos.InputLayout, chronos.PrecisionDay},
+		{"custom layout", "22/09/2023", []func(*chronos.ParseOption){chronos.ParseWithLayout("02/01/2006")}, "02/01/2006", chronos.InputLayout, chronos.PrecisionDay},
+		{"keyword", "now", nil, "", chronos.InputKeyword, chronos.PrecisionNanosecond},
+		{"natural language", "2 hours ago", []func(*chronos.ParseOption){chronos.ParseWithNaturalLanguage(true)}, "", chronos.InputNaturalLanguage, chronos.PrecisionHour},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := chronos.ParseDetailed(tt.input, tt.opts...)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.layout, res.Layout)
+			assert.Equal(t, tt.kind, res.Kind)
+			assert.Equal(t, chronos.UnitNone, res.Unit)
+			assert.Equal(t, tt.precision, res.Precision)
+		})
+	}
+
+	t.Run("timestamp units", func(t *testing.T) {
+		units := map[int64]chronos.TimestampUnit{
+			1672643045:          chronos.UnitSecond,
+			1672643045123:       chronos.UnitMillisecond,
+			1672643045123456:    chronos.UnitMicrosecond,
+			1672643045123456789: chronos.UnitNanosecond,
+		}
+		for v, unit := range units {
+			res, err := chronos.ParseDetailed(v)
+			assert.NoError(t, err)
+			assert.Equal(t, chronos.InputTimestamp, res.Kind)
+			assert.Equal(t, unit, res.Unit)
+		}
+	})
+
+	t.Run("time input", func(t *testing.T) {
+		now := time.Now()
+		res, err := chronos.ParseDetailed(now)
+		assert.NoError(t, err)
+		assert.Equal(t, now, res.Time)
+		assert.Equal(t, chronos.InputTime, res.Kind)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		res, err := chronos.ParseDetailed("invalid-time-string")
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+}
+
+func TestParse_NumericString(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		expected  time.Time
+		unit      chronos.TimestampUnit
+		precision chronos.Precision
+	}{
+		{"seconds", "1672643045", time.Unix(1672643045, 0), chronos.UnitSecond, chronos.PrecisionSecond},
+		{"milliseconds", "1672643045123", time.Unix(1672643045, 123000000), chronos.UnitMillisecond, chronos.PrecisionMillisecond},
+		{"nanoseconds", "1672643045123456789", time.Unix(1672643045, 123456789), chronos.UnitNanosecond, chronos.PrecisionNanosecond},
+		{"fractional seconds", "1672643045.123456", time.Unix(1672643045, 123456000), chronos.UnitSecond, chronos.PrecisionMicrosecond},
+		{"fractional nanoseconds", "1672643045.123456789", time.Unix(1672643045, 123456789), chronos.UnitSecond, chronos.PrecisionNanosecond},
+		{"fractional milliseconds", "1672643045123.5", time.Unix(1672643045, 123500000), chronos.UnitMillisecond, chronos.PrecisionMicrosecond},
+		{"negative fractional", "-1.5", time.Unix(-2, 500000000), chronos.UnitSecond, chronos.PrecisionMillisecond},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := chronos.ParseDetailed(tt.input)
+			assert.NoError(t, err)
+			assert.True(t, tt.expected.Equal(res.Time), "got %v, want %v", res.Time, tt.expected)
+			assert.Equal(t, chronos.InputTimestamp, res.Kind)
+			assert.Equal(t, tt.unit, res.Unit)
+			assert.Equal(t, tt.precision, res.Precision)
+		})
+	}
+
+	t.Run("forced unit", func(t *testing.T) {
+		at, err := chronos.Parse("1672643045.5", chronos.ParseWithUnixUnit(chronos.UnitMillisecond))
+		assert.NoError(t, err)
+		assert.True(t, at.Equal(time.Unix(1672643, 45500000)))
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		at, err := chronos.Parse("1672643045", chronos.ParseWithNumericString(false))
+		assert.Error(t, err)
+		assert.Nil(t, at)
+	})
+
+	t.Run("malformed", func(t *testing.T) {
+		for _, input := range []string{"1672643045.", "--1672643045", "1672643045.12a", "1.2.3"} {
+			at, err := chronos.Parse(input)
+			assert.Error(t, err, input)
+			assert.Nil(t, at)
+		}
+	})
+}
+
+func TestParse_Tokens(t *testing.T) {
+	t.Run("enabled", func(t *testing.T) {
+		res, err := chronos.ParseDetailed("Sat April 22 2023 6:22pm",
+			chronos.ParseWithTokens(true),
+			chronos.ParseWithLocation(time.UTC),
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2023, 4, 22, 18, 22, 0, 0, time.UTC), res.Time)
+		assert.Equal(t, chronos.InputTokens, res.Kind)
+	})
+
+	t.Run("zone abbreviation", func(t *testing.T) {
+		at, err := chronos.Parse("22 Apr 2023 CET", chronos.ParseWithTokens(true), chronos.ParseWithLocation(time.UTC))
+		assert.NoError(t, err)
+		assert.True(t, time.Date(2023, 4, 21, 23, 0, 0, 0, time.UTC).Equal(*at), "got %s", at)
+
+		shanghai := time.FixedZone("CST", 8*3600)
+		at, err = chronos.Parse("Sat April 22 2023 6:22pm CST", chronos.ParseWithTokens(true), chronos.ParseWithZonePreference("CST", shanghai))
+		assert.NoError(t, err)
+		assert.True(t, time.Date(2023, 4, 22, 18, 22, 0, 0, shanghai).Equal(*at), "got %s", at)
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		at, err := chronos.Parse("22 Apr 2023 18h22")
+		assert.Error(t, err)
+		assert.Nil(t, at)
+	})
+
+	t.Run("skip unknown tokens", func(t *testing.T) {
+		at, err := chronos.Parse("released on 2023.4.22",
+			chronos.ParseWithTokens(true),
+			chronos.ParseWithSkipUnknownTokens(true),
+			chronos.ParseWithLocation(time.UTC),
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2023, 4, 22, 0, 0, 0, 0, time.UTC), *at)
+	})
+
+	t.Run("digit-only strings", func(t *testing.T) {
+		// 默认按时间戳解析，与其他纯数字字符串一致
+		for _, input := range []string{"2023", "1800", "20230422", "2023112", "202304", "12"} {
+			res, err := chronos.ParseDetailed(input, chronos.ParseWithLocation(time.UTC))
+			if assert.NoError(t, err, input) {
+				assert.Equal(t, chronos.InputTimestamp, res.Kind, input)
+			}
+		}
+
+		// 关闭时间戳解析时按 ISO 8601 基本格式解析
+		opts := []func(*chronos.ParseOption){chronos.ParseWithNumericString(false), chronos.ParseWithLocation(time.UTC)}
+		for input, expected := range map[string]time.Time{
+			"2023":     time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+			"20230422": time.Date(2023, 4, 22, 0, 0, 0, 0, time.UTC),
+			"2023112":  time.Date(2023, 4, 22, 0, 0, 0, 0, time.UTC),
+		} {
+			res, err := chronos.ParseDetailed(input, opts...)
+			if assert.NoError(t, err, input) {
+				assert.Equal(t, expected, res.Time, input)
+				assert.Equal(t, chronos.InputISO8601, res.Kind, input)
+			}
+		}
+		for _, input := range []string{"202304", "12"} {
+			_, err := chronos.Parse(input, opts...)
+			assert.Error(t, err, input)
+		}
+
+		// 自定义格式优先于时间戳
+		res, err := chronos.ParseDetailed("20230422", chronos.ParseWithLayout("20060102"), chronos.ParseWithLocation(time.UTC))
+		assert.NoError(t, err)
+		assert.Equal(t, chronos.InputLayout, res.Kind)
+	})
+
+	t.Run("layouts take precedence", func(t *testing.T) {
+		res, err := chronos.ParseDetailed("2023-04-22", chronos.ParseWithTokens(true))
+		assert.NoError(t, err)
+		assert.Equal(t, chronos.InputLayout, res.Kind)
+	})
+}
+
+func TestParse_DateOrder(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		order     chronos.DateOrder
+		expected  time.Time
+		precision chronos.Precision
+	}{
+		{"DMY slash", "03/04/2023", chronos.DMY, time.Date(2023, 4, 3, 0, 0, 0, 0, time.UTC), chronos.PrecisionDay},
+		{"MDY slash", "03/04/2023", chronos.MDY, time.Date(2023, 3, 4, 0, 0, 0, 0, time.UTC), chronos.PrecisionDay},
+		{"DMY dot", "3.4.2023", chronos.DMY, time.Date(2023, 4, 3, 0, 0, 0, 0, time.UTC), chronos.PrecisionDay},
+		{"MDY dash", "3-4-2023", chronos.MDY, time.Date(2023, 3, 4, 0, 0, 0, 0, time.UTC), chronos.PrecisionDay},
+		{"YMD dot", "2023.4.3", chronos.YMD, time.Date(2023, 4, 3, 0, 0, 0, 0, time.UTC), chronos.PrecisionDay},
+		{"DMY two digit year", "03/04/23", chronos.DMY, time.Date(2023, 4, 3, 0, 0, 0, 0, time.UTC), chronos.PrecisionDay},
+		{"DMY with time", "22/04/2023 18:22:15", chronos.DMY, time.Date(2023, 4, 22, 18, 22, 15, 0, time.UTC), chronos.PrecisionSecond},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := chronos.ParseDetailed(tt.input,
+				chronos.ParseWithDateOrder(tt.order),
+				chronos.ParseWithLocation(time.UTC),
+			)
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.expected, res.Time)
+				assert.Equal(t, tt.precision, res.Precision)
+			}
+		})
+	}
+
+	t.Run("invalid for order", func(t *testing.T) {
+		at, err := chronos.Parse("04/22/2023", chronos.ParseWithDateOrder(chronos.DMY))
+		assert.Error(t, err)
+		assert.Nil(t, at)
+	})
+
+	t.Run("strict ambiguous", func(t *testing.T) {
+		at, err := chronos.Parse("03/04/2023",
+			chronos.ParseWithDateOrder(chronos.DMY),
+			chronos.ParseWithStrict(true),
+			chronos.ParseWithTokens(true),
+		)
+		assert.Error(t, err)
+		assert.Nil(t, at)
+	})
+
+	t.Run("strict unambiguous", func(t *testing.T) {
+		for _, input := range []string{"22/04/2023", "04/04/2023"} {
+			_, err := chronos.Parse(input,
+				chronos.ParseWithDateOrder(chronos.DMY),
+				chronos.ParseWithStrict(true),
+			)
+			assert.NoError(t, err, input)
+		}
+	})
+
+	t.Run("tokens follow order", func(t *testing.T) {
+		at, err := chronos.Parse("03 04 2023",
+			chronos.ParseWithTokens(true),
+			chronos.ParseWithDateOrder(chronos.DMY),
+			chronos.ParseWithLocation(time.UTC),
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2023, 4, 3, 0, 0, 0, 0, time.UTC), *at)
+	})
+}
+
+func BenchmarkParse(b *testing.B) {
+	inputs := []string{"2023-04-22T18:22:15Z", "2023-04-22 18:22:15", "Sat, 22 Apr 2023 18:22:15 GMT", "2023/04/22"}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = chronos.Parse(inputs[i%len(inputs)], chronos.ParseWithLocation(time.UTC))
+	}
+}
+
+func BenchmarkParse_Default(b *testing.B) {
+	inputs := []string{"2023-04-22T18:22:15Z", "2023-04-22 18:22:15", "Sat, 22 Apr 2023 18:22:15 GMT", "2023/04/22"}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = chronos.Parse(inputs[i%len(inputs)])
+	}
+}
+
+func BenchmarkParser(b *testing.B) {
+	inputs := []string{"2023-04-22T18:22:15Z", "2023-04-22 18:22:15", "Sat, 22 Apr 2023 18:22:15 GMT", "2023/04/22"}
+	p := chronos.NewParser(chronos.ParseWithLocation(time.UTC))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = p.Parse(inputs[i%len(inputs)])
+	}
+}
+
+func BenchmarkParser_Parallel(b *testing.B) {
+	inputs := []string{"2023-04-22T18:22:15Z", "2023-04-22 18:22:15", "Sat, 22 Apr 2023 18:22:15 GMT", "2023/04/22"}
+	p := chronos.NewParser(chronos.ParseWithLocation(time.UTC))
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			_, _ = p.Parse(inputs[i%len(inputs)])
+			i++
+		}
+	})
+}
+
+func TestParser(t *testing.T) {
+	p := chronos.NewParser(chronos.ParseWithLocation(time.UTC), chronos.ParseWithLayout("02/01/2006"))
+
+	t.Run("same result as Parse", func(t *testing.T) {
+		inputs := []any{"2023-04-22T18:22:15Z", "22/09/2023", "Sat, 22 Apr 2023 18:22:15 GMT", int64(1672643045123), "1672643045"}
+		for _, input := range inputs {
+			got, err := p.ParseDetailed(input)
+			assert.NoError(t, err)
+
+			var want *chronos.ParseResult
+			switch v := input.(type) {
+			case string:
+				want, err = chronos.ParseDetailed(v, chronos.ParseWithLocation(time.UTC), chronos.ParseWithLayout("02/01/2006"))
+			case int64:
+				want, err = chronos.ParseDetailed(v)
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, want, got)
+		}
+	})
+
+	t.Run("default parser follows time.Local", func(t *testing.T) {
+		local := time.Local
+		defer func() { time.Local = local }()
+
+		for _, offset := range []int{8, -7} {
+			time.Local = time.FixedZone("", offset*3600)
+			at, err := chronos.Parse("2023-04-22 18:22:15")
+			assert.NoError(t, err)
+			assert.Equal(t, time.Date(2023, 4, 22, 18, 22, 15, 0, time.Local), *at)
+		}
+	})
+
+	t.Run("unsupported type", func(t *testing.T) {
+		at, err := p.Parse(true)
+		assert.Error(t, err)
+		assert.Nil(t, at)
+	})
+
+	t.Run("concurrent use", func(t *testing.T) {
+		var wg sync.WaitGroup
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := 0; j < 100; j++ {
+					at, err := p.Parse("2023-04-22 18:22:15")
+					assert.NoError(t, err)
+					assert.Equal(t, time.Date(2023, 4, 22, 18, 22, 15, 0, time.UTC), *at)
+				}
+			}()
+		}
+		wg.Wait()
+	})
+}
+
+func TestParse_ISO8601(t *testing.T) {
+	t.Run("week date", func(t *testing.T) {
+		res, err := chronos.ParseDetailed("2023-W16-6T18:22:15Z")
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2023, 4, 22, 18, 22, 15, 0, time.UTC), res.Time)
+		assert.Equal(t, chronos.InputISO8601, res.Kind)
+	})
+
+	t.Run("basic format uses location", func(t *testing.T) {
+		loc := time.FixedZone("TEST", 3600)
+		at, err := chronos.Parse("20230422T1822", chronos.ParseWithLocation(loc))
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2023, 4, 22, 18, 22, 0, 0, loc), *at)
+	})
+
+	t.Run("layouts take precedence", func(t *testing.T) {
+		res, err := chronos.ParseDetailed("2023-04-22T18:22:15Z")
+		assert.NoError(t, err)
+		assert.Equal(t, chronos.InputLayout, res.Kind)
+	})
+}
+
+func TestParse_Locale(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		locale   chronos.Locale
+		expected time.Time
+	}{
+		{"fr", "22 avril 2023", chronos.LocaleFR, time.Date(2023, 4, 22, 0, 0, 0, 0, time.UTC)},
+		{"fr RFC1123", "sam., 22 avr. 2023 18:22:15 GMT", chronos.LocaleFR, time.Date(2023, 4, 22, 18, 22, 15, 0, time.UTC)},
+		{"de", "22. April 2023", chronos.LocaleDE, time.Date(2023, 4, 22, 0, 0, 0, 0, time.UTC)},
+		{"de with time", "Samstag, 22. April 2023 um 18:22 Uhr", chronos.LocaleDE, time.Date(2023, 4, 22, 18, 22, 0, 0, time.UTC)},
+		{"es", "sábado, 22 de abril de 2023", chronos.LocaleES, time.Date(2023, 4, 22, 0, 0, 0, 0, time.UTC)},
+		{"es pm", "22 de abril de 2023 6:22 p. m.", chronos.LocaleES, time.Date(2023, 4, 22, 18, 22, 0, 0, time.UTC)},
+		{"es a.m.", "22 abr. 2023 6:22 a.m.", chronos.LocaleES, time.Date(2023, 4, 22, 6, 22, 0, 0, time.UTC)},
+		{"zh-CN", "2023年4月22日 星期六", chronos.LocaleZhCN, time.Date(2023, 4, 22, 0, 0, 0, 0, time.UTC)},
+		{"zh-CN pm", "2023年4月22日 下午6点22分", chronos.LocaleZhCN, time.Date(2023, 4, 22, 18, 22, 0, 0, time.UTC)},
+		{"ja", "2023年4月22日(土) 午後6時22分", chronos.LocaleJA, time.Date(2023, 4, 22, 18, 22, 0, 0, time.UTC)},
+		{"ja weekday", "2023年4月22日(土曜日) 午後6時22分", chronos.LocaleJA, time.Date(2023, 4, 22, 18, 22, 0, 0, time.UTC)},
+		{"en", "Saturday, April 22, 2023", chronos.LocaleEN, time.Date(2023, 4, 22, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			at, err := chronos.Parse(tt.input,
+				chronos.ParseWithLocale(tt.locale),
+				chronos.ParseWithLocation(time.UTC),
+			)
+			assert.NoError(t, err)
+			assert.True(t, tt.expected.Equal(*at), "got %s", at)
+		})
+	}
+
+	t.Run("weekday mismatch", func(t *testing.T) {
+		_, err := chronos.Parse("2023年4月22日 星期五", chronos.ParseWithLocale(chronos.LocaleZhCN))
+		assert.Error(t, err)
+	})
+
+	t.Run("unknown locale", func(t *testing.T) {
+		_, err := chronos.Parse("22 avril 2023", chronos.ParseWithLocale("xx"))
+		assert.Error(t, err)
+	})
+
+	t.Run("without locale", func(t *testing.T) {
+		_, err := chronos.Parse("22 avril 2023")
+		assert.Error(t, err)
+	})
+}
+
+func TestParse_Chinese(t *testing.T) {
+	base := time.Date(2023, 5, 15, 12, 0, 0, 0, time.UTC)
+	opts := []func(*chronos.ParseOption){
+		chronos.ParseWithChinese(true),
+		chronos.ParseWithBaseTime(base),
+		chronos.ParseWithLocation(time.UTC),
+	}
+
+	tests := []struct {
+		input    string
+		expected time.Time
+	}{
+		{"2023年04月22日", time.Date(2023, 4, 22, 0, 0, 0, 0, time.UTC)},
+		{"2023年4月22日 18时22分15秒", time.Date(2023, 4, 22, 18, 22, 15, 0, time.UTC)},
+		{"4月22日", time.Date(2023, 4, 22, 0, 0, 0, 0, time.UTC)},
+		{"下午3点15分", time.Date(2023, 5, 15, 15, 15, 0, 0, time.UTC)},
+		{"晚上8点半", time.Date(2023, 5, 15, 20, 30, 0, 0, time.UTC)},
+		{"二〇二三年四月二十二日", time.Date(2023, 4, 22, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			res, err := chronos.ParseDetailed(tt.input, opts...)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, res.Time)
+			assert.Equal(t, chronos.InputChinese, res.Kind)
+		})
+	}
+
+	t.Run("disabled by default", func(t *testing.T) {
+		_, err := chronos.Parse("2023年04月22日")
+		assert.Error(t, err)
+	})
+}
+
+func TestParse_ZoneAbbreviation(t *testing.T) {
+	t.Run("known abbreviation", func(t *testing.T) {
+		at, err := chronos.Parse("Sat, 22 Apr 2023 18:22:15 PST", chronos.ParseWithLocation(time.UTC))
+		assert.NoError(t, err)
+		assert.True(t, time.Date(2023, 4, 23, 2, 22, 15, 0, time.UTC).Equal(*at), "got %s", at)
+	})
+
+	t.Run("preference", func(t *testing.T) {
+		at, err := chronos.Parse("Sat, 22 Apr 2023 18:22:15 CST",
+			chronos.ParseWithLocation(time.UTC),
+			chronos.ParseWithZonePreference("CST", time.FixedZone("CST", 8*3600)),
+		)
+		assert.NoError(t, err)
+		assert.True(t, time.Date(2023, 4, 22, 10, 22, 15, 0, time.UTC).Equal(*at), "got %s", at)
+	})
+
+	t.Run("unknown abbreviation", func(t *testing.T) {
+		_, err := chronos.Parse("Sat, 22 Apr 2023 18:22:15 XYZ", chronos.ParseWithLocation(time.UTC))
+		assert.Error(t, err)
+	})
+}
+
+func TestParse_ZoneName(t *testing.T) {
+	shanghai, err := time.LoadLocation("Asia/Shanghai")
+	if err != nil {
+		t.Skip("time zone database not available")
+	}
+	expected := time.Date(2023, 4, 22, 18, 22, 15, 0, shanghai)
+
+	for _, input := range []string{"2023-04-22 18:22:15 Asia/Shanghai", "2023-04-22T18:22:15+08:00[Asia/Shanghai]", "2023-04-22T10:22:15Z[Asia/Shanghai]"} {
+		t.Run(input, func(t *testing.T) {
+			at, err := chronos.Parse(input, chronos.ParseWithLocation(time.UTC))
+			assert.NoError(t, err)
+			assert.True(t, expected.Equal(*at), "got %s", at)
+			assert.Equal(t, "Asia/Shanghai", at.Location().String())
+		})
+	}
+
+	t.Run("trailing name without slash", func(t *testing.T) {
+		at, err := chronos.Parse("2023-04-22 18:22:15 UTC", chronos.ParseWithLocation(shanghai))
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2023, 4, 22, 18, 22, 15, 0, time.UTC), *at)
+
+		at, err = chronos.Parse("2023-04-22 18:22:15 PRC", chronos.ParseWithLocation(time.UTC))
+		assert.NoError(t, err)
+		assert.True(t, expected.Equal(*at), "got %s", at)
+	})
+
+	t.Run("conflict", func(t *testing.T) {
+		input := "2023-04-22T18:22:15+09:00[Asia/Shanghai]"
+		_, err := chronos.Parse(input)
+		assert.ErrorIs(t, err, chronos.ErrAmbiguous)
+		var pe *chronos.ParseError
+		if assert.ErrorAs(t, err, &pe) {
+			assert.Equal(t, "zone", pe.Component)
+		}
+
+		_, err = chronos.Parse("2023-04-22 18:22:15 +0900 Asia/Shanghai", chronos.ParseWithLayout("2006-01-02 15:04:05 -0700"))
+		assert.ErrorIs(t, err, chronos.ErrAmbiguous)
+
+		at, err := chronos.Parse(input, chronos.ParseWithZoneConflict(chronos.ZoneConflictUseOffset))
+		assert.NoError(t, err)
+		assert.True(t, expected.Add(-time.Hour).Equal(*at), "got %s", at)
+	})
+}
+
+func TestParse_ExcelSerial(t *testing.T) {
+	opts := []func(*chronos.ParseOption){chronos.ParseWithExcelSerial(chronos.Excel1900), chronos.ParseWithLocation(time.UTC)}
+
+	t.Run("integer", func(t *testing.T) {
+		res, err := chronos.ParseDetailed(45038, opts...)
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2023, 4, 22, 0, 0, 0, 0, time.UTC), res.Time)
+		assert.Equal(t, chronos.InputExcelSerial, res.Kind)
+	})
+
+	t.Run("fractional string", func(t *testing.T) {
+		at, err := chronos.Parse("45038.765", opts...)
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2023, 4, 22, 18, 21, 36, 0, time.UTC), *at)
+	})
+
+	t.Run("1904 system", func(t *testing.T) {
+		at, err := chronos.Parse(43576, chronos.ParseWithExcelSerial(chronos.Excel1904), chronos.ParseWithLocation(time.UTC))
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2023, 4, 22, 0, 0, 0, 0, time.UTC), *at)
+	})
+
+	t.Run("location", func(t *testing.T) {
+		loc := time.FixedZone("UTC+8", 8*3600)
+		at, err := chronos.Parse("45038.5", chronos.ParseWithExcelSerial(chronos.Excel1900), chronos.ParseWithLocation(loc))
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2023, 4, 22, 12, 0, 0, 0, loc), *at)
+	})
+
+	t.Run("non-existent 1900-02-29", func(t *testing.T) {
+		_, err := chronos.Parse(60, opts...)
+		assert.Error(t, err)
+	})
+
+	t.Run("other strings keep working", func(t *testing.T) {
+		at, err := chronos.Parse("2023-04-22", opts...)
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2023, 4, 22, 0, 0, 0, 0, time.UTC), *at)
+	})
+}
+
+func TestParse_Epoch(t *testing.T) {
+	expected := time.Date(2023, 4, 22, 18, 22, 15, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		epoch chronos.Epoch
+		value any
+	}{
+		{"filetime", chronos.EpochFileTime, int64(133266613350000000)},
+		{".net ticks", chronos.EpochDotNetTicks, uint64(638177845350000000)},
+		{"ntp", chronos.EpochNTP, "3891176535"},
+		{"gps", chronos.EpochGPS, 1366222953},
+		{"cocoa", chronos.EpochCocoa, "703880535"},
+		{"julian day", chronos.EpochJulianDay, "2460057.265451388889"},
+		{"modified julian day", chronos.EpochModifiedJulianDay, "60056.765451388889"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			at, err := chronos.NewParser(chronos.ParseWithEpoch(tt.epoch)).Parse(tt.value)
+			assert.NoError(t, err)
+			// 以天为单位的小数存在舍入误差
+			assert.WithinDuration(t, expected, *at, time.Microsecond)
+		})
+	}
+
+	t.Run("out of range", func(t *testing.T) {
+		_, err := chronos.Parse(0, chronos.ParseWithEpoch(chronos.EpochJulianDay))
+		assert.Error(t, err)
+	})
+
+	t.Run("ntp era rollover", func(t *testing.T) {
+		_, err := chronos.Parse("4294967296", chronos.ParseWithEpoch(chronos.EpochNTP))
+		assert.ErrorIs(t, err, chronos.ErrOutOfRange)
+
+		at, err := chronos.Parse(1000, chronos.ParseWithEpoch(chronos.NTPEra(1)))
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2036, 2, 7, 6, 44, 56, 0, time.UTC), at.UTC())
+	})
+}
+
+func TestParse_Keyword(t *testing.T) {
+	base := time.Date(2023, 5, 15, 14, 30, 0, 0, time.UTC)
+	opts := []func(*chronos.ParseOption){chronos.ParseWithBaseTime(base), chronos.ParseWithLocation(time.UTC)}
+
+	tests := []struct {
+		input     string
+		expected  time.Time
+		precision chronos.Precision
+	}{
+		{"now", base, chronos.PrecisionNanosecond},
+		{"today", time.Date(2023, 5, 15, 0, 0, 0, 0, time.UTC), chronos.PrecisionDay},
+		{"yesterday", time.Date(2023, 5, 14, 0, 0, 0, 0, time.UTC), chronos.PrecisionDay},
+		{"Tomorrow", time.Date(2023, 5, 16, 0, 0, 0, 0, time.UTC), chronos.PrecisionDay},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			res, err := chronos.ParseDetailed(tt.input, opts...)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, res.Time)
+			assert.Equal(t, chronos.InputKeyword, res.Kind)
+			assert.Equal(t, tt.precision, res.Precision)
+
+			// 开启自然语言解析时结果一致
+			nl, err := chronos.Parse(tt.input, append(opts, chronos.ParseWithNaturalLanguage(true))...)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, *nl)
+		})
+	}
+
+	t.Run("register", func(t *testing.T) {
+		err := chronos.RegisterKeyword("test-eod", chronos.PrecisionNanosecond, func(base time.Time) time.Time {
+			return chronos.EndOfDay(base)
+		})
+		assert.NoError(t, err)
+		err = chronos.RegisterKeyword("test-epoch", chronos.PrecisionSecond, func(time.Time) time.Time {
+			return time.Unix(0, 0).UTC()
+		})
+		assert.NoError(t, err)
+
+		at, err := chronos.Parse("TEST-EOD", opts...)
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2023, 5, 15, 23, 59, 59, 999999999, time.UTC), *at)
+
+		at, err = chronos.Parse("test-epoch")
+		assert.NoError(t, err)
+		assert.Equal(t, time.Unix(0, 0).UTC(), *at)
+	})
+
+	t.Run("register invalid", func(t *testing.T) {
+		assert.Error(t, chronos.RegisterKeyword(" ", chronos.PrecisionDay, func(base time.Time) time.Time { return base }))
+		assert.Error(t, chronos.RegisterKeyword("test-nil", chronos.PrecisionDay, nil))
+	})
+}
+
+func TestParse_YearInference(t *testing.T) {
+	base := time.Date(2024, 1, 3, 9, 0, 0, 0, time.UTC)
+	opts := []func(*chronos.ParseOption){chronos.ParseWithBaseTime(base), chronos.ParseWithLocation(time.UTC)}
+
+	tests := []struct {
+		input    string
+		expected time.Time
+	}{
+		{"Dec 31 23:59:58", time.Date(2023, 12, 31, 23, 59, 58, 0, time.UTC)},
+		{"Jan  2 00:00:01", time.Date(2024, 1, 2, 0, 0, 1, 0, time.UTC)},
+		{"Jan  3 09:00:00.250", time.Date(2024, 1, 3, 9, 0, 0, 250000000, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			res, err := chronos.ParseDetailed(tt.input, opts...)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, res.Time)
+			assert.Equal(t, chronos.InputLayout, res.Kind)
+		})
+	}
+}
+
+func TestParse_AnchorDate(t *testing.T) {
+	base := time.Date(2024, 3, 10, 18, 0, 0, 0, time.UTC)
+	opts := []func(*chronos.ParseOption){chronos.ParseWithBaseTime(base), chronos.ParseWithLocation(time.UTC)}
+
+	at, err := chronos.Parse("3:04PM", append(opts, chronos.ParseWithAnchorDate(time.Date(2023, 4, 22, 0, 0, 0, 0, time.UTC)))...)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2023, 4, 22, 15, 4, 0, 0, time.UTC), *at)
+
+	at, err = chronos.Parse("15:04:05", append(opts, chronos.ParseWithAnchorDate(time.Time{}))...)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 3, 10, 15, 4, 5, 0, time.UTC), *at)
+
+	at, err = chronos.Parse("15:04:05", append(opts, chronos.ParseWithNextOccurrence(true))...)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 3, 11, 15, 4, 5, 0, time.UTC), *at)
+
+	at, err = chronos.Parse("15:04:05", opts...)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(0, 1, 1, 15, 4, 5, 0, time.UTC), *at)
+}
+
+func TestParse_TwoDigitYear(t *testing.T) {
+	base := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	opts := []func(*chronos.ParseOption){chronos.ParseWithBaseTime(base), chronos.ParseWithLocation(time.UTC)}
+
+	at, err := chronos.Parse("Mon, 02 Jan 45 15:04:05 +0000", opts...)
+	assert.NoError(t, err)
+	assert.Equal(t, 2045, at.Year())
+
+	at, err = chronos.Parse("Mon, 02 Jan 45 15:04:05 +0000", append(opts, chronos.ParseWithTwoDigitYearPivot(1930))...)
+	assert.NoError(t, err)
+	assert.Equal(t, 1945, at.Year())
+
+	at, err = chronos.Parse("02/01/30", append(opts, chronos.ParseWithLayout("02/01/06"), chronos.ParseWithTwoDigitYearWindow(0))...)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(1930, 1, 2, 0, 0, 0, 0, time.UTC), *at)
+
+	at, err = chronos.Parse("02/01/24", append(opts, chronos.ParseWithLayout("02/01/06"), chronos.ParseWithTwoDigitYearWindow(0))...)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), *at)
+}
+
+func TestParse_TimeNormalization(t *testing.T) {
+	opts := []func(*chronos.ParseOption){chronos.ParseWithLocation(time.UTC)}
+
+	_, err := chronos.Parse("2023-04-22T24:00:00", opts...)
+	assert.ErrorIs(t, err, chronos.ErrOutOfRange)
+
+	res, err := chronos.ParseDetailed("2023-04-22T24:00:00", append(opts, chronos.ParseWithTimeNormalization(chronos.LeapSecondReject))...)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2023, 4, 23, 0, 0, 0, 0, time.UTC), res.Time)
+	assert.Equal(t, chronos.NormalizationEndOfDay, res.Normalized)
+
+	_, err = chronos.Parse("2016-12-31T23:59:60Z", append(opts, chronos.ParseWithTimeNormalization(chronos.LeapSecondReject))...)
+	assert.Error(t, err)
+
+	res, err = chronos.ParseDetailed("2016-12-31T23:59:60Z", append(opts, chronos.ParseWithTimeNormalization(chronos.LeapSecondClamp))...)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2016, 12, 31, 23, 59, 59, 999999999, time.UTC), res.Time)
+	assert.Equal(t, chronos.NormalizationLeapSecond, res.Normalized)
+
+	res, err = chronos.ParseDetailed("2016-12-31T23:59:60Z", append(opts, chronos.ParseWithTimeNormalization(chronos.LeapSecondRollOver))...)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC), res.Time)
+
+	res, err = chronos.ParseDetailed("2023-04-22T18:22:15Z", append(opts, chronos.ParseWithTimeNormalization(chronos.LeapSecondClamp))...)
+	assert.NoError(t, err)
+	assert.Equal(t, chronos.NormalizationNone, res.Normalized)
+}
+
+func TestParse_Lenient(t *testing.T) {
+	opts := []func(*chronos.ParseOption){chronos.ParseWithLocation(time.UTC)}
+	date := time.Date(2023, 4, 22, 0, 0, 0, 0, time.UTC)
+
+	for _, input := range []string{" april 22nd, 2023 ", "22ND APR 2023", "Sat., Apr. 22, 2023"} {
+		_, err := chronos.Parse(input, opts...)
+		assert.Error(t, err, input)
+
+		at, err := chronos.Parse(input, append(opts, chronos.ParseWithLenient(true))...)
+		assert.NoError(t, err, input)
+		assert.Equal(t, date, *at, input)
+	}
+
+	res, err := chronos.ParseDetailed("2023-04-22t18:22:15z", append(opts, chronos.ParseWithLenient(true))...)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2023, 4, 22, 18, 22, 15, 0, time.UTC), res.Time)
+	assert.Equal(t, chronos.InputLayout, res.Kind)
+}
+
+func TestParse_Error(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     any
+		opts      []func(*chronos.ParseOption)
+		target    error
+		kind      chronos.InputKind
+		component string
+	}{
+		{"unsupported", "hello", nil, chronos.ErrUnsupportedExpression, chronos.InputLayout, ""},
+		{"day out of range", "2023-02-30", nil, chronos.ErrOutOfRange, chronos.InputLayout, "day"},
+		{"hour out of range", "2023-04-22T25:00:00Z", nil, chronos.ErrOutOfRange, chronos.InputLayout, "hour"},
+		{"tokens out of range", "Feb 30 2023", []func(*chronos.ParseOption){chronos.ParseWithTokens(true)}, chronos.ErrOutOfRange, chronos.InputTokens, "day"},
+		{"ambiguous", "04/05/2023", []func(*chronos.ParseOption){chronos.ParseWithDateOrder(chronos.DMY), chronos.ParseWithStrict(true)}, chronos.ErrAmbiguous, chronos.InputLayout, "date"},
+		{"unknown unit", "3 fortnights ago", []func(*chronos.ParseOption){chronos.ParseWithNaturalLanguage(true)}, chronos.ErrUnknownUnit, chronos.InputNaturalLanguage, "unit"},
+		{"chinese out of range", "2023年2月30日", []func(*chronos.ParseOption){chronos.ParseWithChinese(true)}, chronos.ErrOutOfRange, chronos.InputChinese, "day"},
+		{"excel out of range", -1, []func(*chronos.ParseOption){chronos.ParseWithExcelSerial(chronos.Excel1900)}, chronos.ErrOutOfRange, chronos.InputExcelSerial, "value"},
+		{"timestamp unit", 1682187735, []func(*chronos.ParseOption){chronos.ParseWithUnixUnit(chronos.TimestampUnit(9))}, chronos.ErrUnknownUnit, chronos.InputTimestamp, "unit"},
+		{"unknown locale", "22 avril 2023", []func(*chronos.ParseOption){chronos.ParseWithLocale("xx")}, chronos.ErrUnsupportedExpression, chronos.InputUnknown, "locale"},
+		{"tokens weekday mismatch", "Mon 22 Apr 2023", []func(*chronos.ParseOption){chronos.ParseWithTokens(true)}, chronos.ErrOutOfRange, chronos.InputTokens, "weekday"},
+		{"chinese weekday mismatch", "2023年4月22日 星期五", []func(*chronos.ParseOption){chronos.ParseWithChinese(true)}, chronos.ErrOutOfRange, chronos.InputChinese, "weekday"},
+		{"zone conflict", "2023-04-22T18:22:15+09:00[Asia/Shanghai]", nil, chronos.ErrAmbiguous, chronos.InputLayout, "zone"},
+		{"day-first date without layout", "31/12/2023", nil, chronos.ErrUnsupportedExpression, chronos.InputLayout, ""},
+		{"ordinal day out of range", "2023-366", nil, chronos.ErrOutOfRange, chronos.InputISO8601, "day"},
+		{"clock without layout", "16:75", nil, chronos.ErrUnsupportedExpression, chronos.InputLayout, ""},
+		{"digits without numeric strings", "1672643045", []func(*chronos.ParseOption){chronos.ParseWithNumericString(false)}, chronos.ErrUnsupportedExpression, chronos.InputLayout, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := chronos.NewParser(tt.opts...).Parse(tt.input)
+			assert.ErrorIs(t, err, tt.target)
+
+			var pe *chronos.ParseError
+			if assert.ErrorAs(t, err, &pe) {
+				assert.Equal(t, fmt.Sprint(tt.input), pe.Input)
+				assert.Equal(t, tt.kind, pe.Kind)
+				assert.Equal(t, tt.component, pe.Component)
+			}
+		})
+	}
+
+	t.Run("layouts", func(t *testing.T) {
+		_, err := chronos.Parse("2023-02-30", chronos.ParseWithLayout("2006-01-02"))
+		var pe *chronos.ParseError
+		assert.ErrorAs(t, err, &pe)
+		assert.Contains(t, pe.Layouts, "2006-01-02")
+		assert.Contains(t, err.Error(), `"2023-02-30"`)
+	})
+}
+
+func TestParse_NumericAndBytes(t *testing.T) {
+	t.Run("float", func(t *testing.T) {
+		res, err := chronos.ParseDetailed(1672643045.123456)
+		assert.NoError(t, err)
+		assert.Equal(t, time.Unix(1672643045, 123456000), res.Time)
+		assert.Equal(t, chronos.UnitSecond, res.Unit)
+		assert.Equal(t, chronos.PrecisionMicrosecond, res.Precision)
+
+		// 毫秒时间戳的小数部分为微秒
+		at, err := chronos.Parse(1672643045123.5)
+		assert.NoError(t, err)
+		assert.Equal(t, time.UnixMicro(1672643045123500), *at)
+
+		at, err = chronos.Parse(float32(45038.5), chronos.ParseWithExcelSerial(chronos.Excel1900), chronos.ParseWithLocation(time.UTC))
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2023, 4, 22, 12, 0, 0, 0, time.UTC), *at)
+
+		_, err = chronos.NewParser().Parse(math.NaN())
+		assert.ErrorIs(t, err, chronos.ErrOutOfRange)
+	})
+
+	t.Run("json number", func(t *testing.T) {
+		for _, n := range []json.Number{"1672643045.5", "1.6726430455e9"} {
+			at, err := chronos.Parse(n)
+			assert.NoError(t, err)
+			assert.Equal(t, time.Unix(1672643045, 500000000), *at)
+		}
+
+		// 不按格式解析
+		at, err := chronos.Parse(json.Number("20230422"), chronos.ParseWithUnixUnit(chronos.UnitSecond))
+		assert.NoError(t, err)
+		assert.Equal(t, time.Unix(20230422, 0), *at)
+	})
+
+	t.Run("bytes", func(t *testing.T) {
+		at, err := chronos.Parse([]byte("2023-04-22 18:22:15"), chronos.ParseWithLocation(time.UTC))
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2023, 4, 22, 18, 22, 15, 0, time.UTC), *at)
+
+		// 修改切片不影响结果中的时区名及错误中的输入
+		b := []byte("2023-04-22 18:22 JST")
+		at, err = chronos.Parse(b, chronos.ParseWithLayout("2006-01-02 15:04 MST"))
+		assert.NoError(t, err)
+		copy(b, "xxxxxxxxxxxxxxxxxxxx")
+		name, offset := at.Zone()
+		assert.Equal(t, "JST", name)
+		assert.Equal(t, 9*3600, offset)
+
+		b = []byte("not a time")
+		_, err = chronos.Parse(b)
+		copy(b, "xxxxxxxxxx")
+		var pe *chronos.ParseError
+		if assert.ErrorAs(t, err, &pe) {
+			assert.Equal(t, "not a time", pe.Input)
+		}
+	})
+}
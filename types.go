@@ -1,14 +1,94 @@
 package chronos
 
-import "time"
+import (
+	"time"
 
+	"github.com/gomooth/chronos/internal/parse"
+)
+
+// TimeValue 可解析的输入类型
+// 字符串及 []byte 按字符串解析，json.Number 及浮点数按数字解析，浮点数的小数部分为不足一个单位的时间
 type TimeValue interface {
-	~string |
+	~string | ~[]byte |
 		~int | ~int16 | ~int32 | ~int64 |
 		~uint | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64 |
 		time.Time | *time.Time
 }
 
 type MixedTime interface {
 	time.Time | *time.Time
 }
+
+// DateOrder 纯数字日期中年、月、日的顺序
+type DateOrder = parse.DateOrder
+
+const (
+	DMY = parse.DateOrderDMY // 日/月/年
+	MDY = parse.DateOrderMDY // 月/日/年
+	YMD = parse.DateOrderYMD // 年/月/日
+)
+
+// ZoneConflict 输入中的时区名与数字偏移不一致时的处理方式
+type ZoneConflict = parse.ZoneConflict
+
+const (
+	ZoneConflictReject    = parse.ZoneConflictReject    // 返回错误
+	ZoneConflictUseOffset = parse.ZoneConflictUseOffset // 以偏移为准
+	ZoneConflictUseZone   = parse.ZoneConflictUseZone   // 以时区名为准
+)
+
+// LeapSecond 闰秒 23:59:60 的处理策略
+type LeapSecond = parse.LeapSecond
+
+const (
+	LeapSecondReject   = parse.LeapSecondReject   // 返回错误
+	LeapSecondClamp    = parse.LeapSecondClamp    // 截断为 23:59:59.999999999
+	LeapSecondRollOver = parse.LeapSecondRollOver // 顺延为次日 00:00:00
+)
+
+// ExcelSystem 电子表格的日期系统
+type ExcelSystem = parse.ExcelSystem
+
+const (
+	Excel1900 = parse.ExcelSystem1900 // 1900 日期系统，Windows 版 Excel 默认
+	Excel1904 = parse.ExcelSystem1904 // 1904 日期系统，早期 Mac 版 Excel 默认
+)
+
+// Epoch 纪元：以某一时刻为起点，按固定单位计数的时间表示法
+type Epoch = parse.Epoch
+
+var (
+	EpochUnix              = parse.EpochUnix              // 1970-01-01 起的秒数
+	EpochFileTime          = parse.EpochFileTime          // Windows FILETIME，1601-01-01 起的 100 纳秒数
+	EpochDotNetTicks       = parse.EpochDotNetTicks       // .NET DateTime.Ticks，0001-01-01 起的 100 纳秒数
+	EpochNTP               = parse.EpochNTP               // NTP 第 0 纪元，1900-01-01 起的秒数
+	EpochGPS               = parse.EpochGPS               // GPS 时间，1980-01-06 起的秒数，不含闰秒
+	EpochCocoa             = parse.EpochCocoa             // Apple Cocoa，2001-01-01 起的秒数
+	EpochJulianDay         = parse.EpochJulianDay         // 儒略日，公元前 4713-01-01 正午（儒略历）起的天数
+	EpochModifiedJulianDay = parse.EpochModifiedJulianDay // 简化儒略日，1858-11-17 起的天数
+)
+
+// NewEpoch 自定义纪元，origin 为计数 0 对应的时刻，unit 为每个计数的时长
+// unit 须为整秒，或能整除一秒
+func NewEpoch(name string, origin time.Time, unit time.Duration) Epoch {
+	return parse.NewEpoch(name, origin, unit)
+}
+
+// NTPEra NTP 第 era 纪元，第 1 纪元始于 2036-02-07 06:28:16 UTC
+// 计数须在 0 至 4294967295 之间，超出时返回 ErrOutOfRange，不会自动推断纪元
+func NTPEra(era int) Epoch {
+	return parse.NTPEra(era)
+}
+
+// Locale 输入的语言
+type Locale string
+
+const (
+	LocaleEN   Locale = "en"    // 英语
+	LocaleZhCN Locale = "zh-CN" // 简体中文
+	LocaleJA   Locale = "ja"    // 日语
+	LocaleFR   Locale = "fr"    // 法语
+	LocaleDE   Locale = "de"    // 德语
+	LocaleES   Locale = "es"    // 西班牙语
+)